@@ -0,0 +1,485 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/akerl/github-auth-lambda/audit"
+	"github.com/akerl/github-auth-lambda/auth"
+	"github.com/akerl/github-auth-lambda/session"
+	"github.com/akerl/go-lambda/apigw/events"
+	"github.com/google/go-github/v25/github"
+	"github.com/google/uuid"
+)
+
+const accessReasonMax = 1000
+
+var accessEvents = map[string]string{
+	"approve": audit.AccessApproved,
+	"reject":  audit.AccessRejected,
+}
+
+func accessVars(ar auth.AccessRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"login":  ar.Login,
+		"app":    ar.App,
+		"target": ar.Target,
+		"need":   ar.Need,
+		"reason": ar.Reason,
+	}
+}
+
+// encodeAccess signs a request for an action with a key derived from the
+// ServerKey, as every app using SessionCheck holds the cookie SignKey
+func encodeAccess(purpose string, ar auth.AccessRequest) (string, string, error) {
+	return auth.EncodeAccessRequest(serverKey("access"), purpose, ar)
+}
+
+func decodeAccess(purpose, payload, sig string) (auth.AccessRequest, error) {
+	return auth.DecodeAccessRequest(serverKey("access"), purpose, payload, sig)
+}
+
+// csrfToken ties a form to the session that loaded it, since the session
+// cookie is sent on cross-site form posts
+func csrfToken(sess session.Session, payload string) string {
+	mac := hmac.New(sha256.New, serverKey("csrf"))
+	mac.Write([]byte(sess.Login + ":" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func validCSRF(sess session.Session, payload, token string) bool {
+	return hmac.Equal([]byte(token), []byte(csrfToken(sess, payload)))
+}
+
+func formValues(req events.Request) (url.Values, error) {
+	if req.HTTPMethod != http.MethodPost {
+		values := url.Values{}
+		for k, v := range req.QueryStringParameters {
+			values.Set(k, v)
+		}
+		for k, v := range req.MultiValueQueryStringParameters {
+			values[k] = v
+		}
+		return values, nil
+	}
+	body := req.Body
+	if req.IsBase64Encoded {
+		data, err := base64.StdEncoding.DecodeString(body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(data)
+	}
+	return url.ParseQuery(body)
+}
+
+// loginFirst sends the user through /auth and back to the current page
+func loginFirst(req events.Request) (events.Response, error) {
+	values, err := formValues(req)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to parse request: %s", err))
+	}
+	returnURL := url.URL{
+		Scheme:   "https",
+		Host:     req.Headers["Host"],
+		Path:     req.Path,
+		RawQuery: values.Encode(),
+	}
+	authURL := url.URL{
+		Scheme:   "https",
+		Host:     req.Headers["Host"],
+		Path:     "/auth",
+		RawQuery: url.Values{"redirect": {returnURL.String()}}.Encode(),
+	}
+	return events.Redirect(authURL.String(), 303)
+}
+
+func accessHandler(req events.Request) (events.Response, error) {
+	if !config.accessEnabled() {
+		return missingHandler(req)
+	}
+	sess, err := sm.Read(req)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed loading session cookie: %s", err))
+	}
+	if sess.Login == "" {
+		return loginFirst(req)
+	}
+	values, err := formValues(req)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to parse request: %s", err))
+	}
+
+	if req.HTTPMethod == http.MethodPost {
+		return submitAccessRequest(req, sess, values)
+	}
+
+	denied, err := auth.DecodeAccessRequest(config.SignKey, auth.DenialPurpose, values.Get("request"), values.Get("sig"))
+	if err != nil {
+		requestLog.Warn("invalid access request link", "login", sess.Login, "error", err)
+		return events.Reject("invalid access request")
+	}
+	if !strings.EqualFold(denied.Login, sess.Login) {
+		return events.Reject("access request belongs to another user")
+	}
+	if !validTarget(req, denied.Target) {
+		return events.Reject("invalid access request target")
+	}
+
+	u, err := uuid.NewRandom()
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to generate request ID: %s", err))
+	}
+	ar := auth.AccessRequest{
+		ID:      u.String(),
+		Login:   sess.Login,
+		UserID:  sess.UserID,
+		App:     denied.App,
+		Target:  denied.Target,
+		Need:    denied.Need,
+		Created: time.Now().Unix(),
+	}
+	payload, sig, err := encodeAccess("request", ar)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to encode access request: %s", err))
+	}
+	return renderPage(req, "/access.html", 200, map[string]interface{}{
+		"access":  accessVars(ar),
+		"payload": payload,
+		"sig":     sig,
+		"csrf":    csrfToken(sess, payload),
+	})
+}
+
+func submitAccessRequest(req events.Request, sess session.Session, values url.Values) (events.Response, error) {
+	payload := values.Get("request")
+	if !validCSRF(sess, payload, values.Get("csrf")) {
+		return events.Reject("invalid form token")
+	}
+	ar, err := decodeAccess("request", payload, values.Get("sig"))
+	if err != nil {
+		requestLog.Warn("invalid access request", "login", sess.Login, "error", err)
+		return events.Reject("invalid access request")
+	}
+	if !strings.EqualFold(ar.Login, sess.Login) {
+		return events.Reject("access request belongs to another user")
+	}
+	ar.Reason = strings.TrimSpace(values.Get("reason"))
+	if len(ar.Reason) > accessReasonMax {
+		ar.Reason = ar.Reason[:accessReasonMax]
+	}
+
+	err = notifyAccessRequest(requestCtx, req, &ar)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to notify approvers: %s", err))
+	}
+
+	event := audit.NewEvent(audit.AccessRequested, req, sess)
+	event.Target = ar.Target
+	event.Reason = strings.Join(ar.Need, "; ")
+	audit.Emit(auditSink, event)
+	requestLog.Info("access requested", "login", ar.Login, "app", ar.App, "need", ar.Need, "issue", ar.Issue)
+
+	return renderPage(req, "/access.html", 200, map[string]interface{}{
+		"access":    accessVars(ar),
+		"submitted": true,
+	})
+}
+
+func decideHandler(req events.Request) (events.Response, error) {
+	if !config.accessEnabled() {
+		return missingHandler(req)
+	}
+	sess, err := sm.Read(req)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed loading session cookie: %s", err))
+	}
+	if sess.Login == "" {
+		return loginFirst(req)
+	}
+	values, err := formValues(req)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to parse request: %s", err))
+	}
+
+	action := values.Get("action")
+	eventType, ok := accessEvents[action]
+	if !ok {
+		return events.Respond(400, "unknown action")
+	}
+	payload := values.Get("request")
+	ar, err := decodeAccess(action, payload, values.Get("sig"))
+	if err != nil {
+		requestLog.Warn("invalid access decision", "login", sess.Login, "error", err)
+		return events.Reject("invalid access request")
+	}
+	if strings.EqualFold(ar.Login, sess.Login) {
+		return events.Reject("you can't decide your own access request")
+	}
+	approver, err := config.Approvers.Matches(req, sess)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to check approvers: %s", err))
+	}
+	if !approver {
+		requestLog.Warn("access decision by non-approver", "login", sess.Login, "requester", ar.Login, "app", ar.App)
+		return events.Reject("you aren't an approver for access requests")
+	}
+	decided, err := accessDecided(requestCtx, ar)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to check access request: %s", err))
+	}
+	if decided {
+		return renderPage(req, "/decide.html", 409, map[string]interface{}{
+			"access":          accessVars(ar),
+			"action":          action,
+			"already_decided": true,
+		})
+	}
+
+	// Links can be opened by chat and email previews, so only act on the
+	// confirmation form
+	if req.HTTPMethod != http.MethodPost {
+		return renderPage(req, "/decide.html", 200, map[string]interface{}{
+			"access":  accessVars(ar),
+			"action":  action,
+			"payload": payload,
+			"sig":     values.Get("sig"),
+			"csrf":    csrfToken(sess, payload),
+		})
+	}
+	if !validCSRF(sess, payload, values.Get("csrf")) {
+		return events.Reject("invalid form token")
+	}
+
+	err = notifyAccessDecision(requestCtx, ar, eventType, sess.Login)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to record access decision: %s", err))
+	}
+
+	event := audit.NewEvent(eventType, req, sess)
+	event.Target = ar.Target
+	event.Reason = fmt.Sprintf("%s for %s", ar.Login, ar.App)
+	audit.Emit(auditSink, event)
+	requestLog.Info("access request decided", "approver", sess.Login, "login", ar.Login, "app", ar.App, "action", action)
+
+	return renderPage(req, "/decide.html", 200, map[string]interface{}{
+		"access":  accessVars(ar),
+		"action":  action,
+		"decided": true,
+	})
+}
+
+func accessLinks(req events.Request, ar auth.AccessRequest) (map[string]string, error) {
+	links := map[string]string{}
+	for action := range accessEvents {
+		payload, sig, err := encodeAccess(action, ar)
+		if err != nil {
+			return nil, err
+		}
+		u := url.URL{
+			Scheme: "https",
+			Host:   req.Headers["Host"],
+			Path:   "/access/decide",
+			RawQuery: url.Values{
+				"action":  {action},
+				"request": {payload},
+				"sig":     {sig},
+			}.Encode(),
+		}
+		links[action] = u.String()
+	}
+	return links, nil
+}
+
+// notifyAccessRequest opens an issue in the AccessRepo and posts to the
+// AccessWebhook, each carrying the approve and reject links
+func notifyAccessRequest(ctx context.Context, req events.Request, ar *auth.AccessRequest) error {
+	if config.AccessRepo != "" {
+		err := openAccessIssue(ctx, req, ar)
+		if err != nil {
+			return err
+		}
+	}
+	if config.AccessWebhook != "" {
+		links, err := accessLinks(req, *ar)
+		if err != nil {
+			return err
+		}
+		return postAccessWebhook(ctx, map[string]interface{}{
+			"event":       audit.AccessRequested,
+			"request":     ar,
+			"approve_url": links["approve"],
+			"reject_url":  links["reject"],
+		})
+	}
+	return nil
+}
+
+// accessDecided checks whether a request's issue has already been closed, so
+// a link can't be used again once a decision is recorded. Without an
+// AccessRepo there's nowhere to record decisions, so webhook receivers should
+// ignore repeat decisions for a request ID.
+func accessDecided(ctx context.Context, ar auth.AccessRequest) (bool, error) {
+	if config.AccessRepo == "" || ar.Issue == 0 {
+		return false, nil
+	}
+	client, err := accessClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	owner, repo, _ := strings.Cut(config.AccessRepo, "/")
+	callCtx, done := startCall(ctx, "github.issues.get")
+	issue, _, err := client.Issues.Get(callCtx, owner, repo, ar.Issue)
+	done(err)
+	if err != nil {
+		return false, err
+	}
+	return issue.GetState() != "open", nil
+}
+
+func notifyAccessDecision(ctx context.Context, ar auth.AccessRequest, eventType, approver string) error {
+	if config.AccessRepo != "" && ar.Issue != 0 {
+		err := closeAccessIssue(ctx, ar, eventType, approver)
+		if err != nil {
+			return err
+		}
+	}
+	if config.AccessWebhook != "" {
+		return postAccessWebhook(ctx, map[string]interface{}{
+			"event":    eventType,
+			"request":  ar,
+			"approver": approver,
+		})
+	}
+	return nil
+}
+
+func postAccessWebhook(ctx context.Context, payload map[string]interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	callCtx, done := startCall(ctx, "access.webhook")
+	httpReq, err := http.NewRequestWithContext(callCtx, http.MethodPost, config.AccessWebhook, bytes.NewReader(body))
+	if err != nil {
+		done(err)
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(httpReq)
+	if err == nil {
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			err = fmt.Errorf("access webhook returned %s", resp.Status)
+		}
+	}
+	done(err)
+	return err
+}
+
+// accessClient returns a client for the app's installation on the AccessRepo owner
+func accessClient(ctx context.Context) (*github.Client, error) {
+	owner, _, _ := strings.Cut(config.AccessRepo, "/")
+	installs, err := appInstallationIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for org, installID := range installs {
+		if strings.EqualFold(org, owner) {
+			return installationClient(ctx, installID)
+		}
+	}
+	return nil, fmt.Errorf("app is not installed on %s", owner)
+}
+
+// inlineCode quotes user-supplied text so it can't inject markdown links
+func inlineCode(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "'") + "`"
+}
+
+func accessIssueBody(ar auth.AccessRequest, links map[string]string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "@%s requested access to %s.\n\n", ar.Login, inlineCode(ar.App))
+	if ar.Target != "" {
+		fmt.Fprintf(&b, "Page: %s\n\n", inlineCode(ar.Target))
+	}
+	if len(ar.Need) > 0 {
+		b.WriteString("Needs one of:\n")
+		for _, n := range ar.Need {
+			fmt.Fprintf(&b, "- %s\n", inlineCode(n))
+		}
+		b.WriteString("\n")
+	}
+	if ar.Reason != "" {
+		fmt.Fprintf(&b, "Reason:\n\n```\n%s\n```\n\n", strings.ReplaceAll(ar.Reason, "`", "'"))
+	}
+	if links != nil {
+		fmt.Fprintf(&b, "[Approve](%s) | [Reject](%s)\n", links["approve"], links["reject"])
+	}
+	return b.String()
+}
+
+func openAccessIssue(ctx context.Context, req events.Request, ar *auth.AccessRequest) error {
+	client, err := accessClient(ctx)
+	if err != nil {
+		return err
+	}
+	owner, repo, _ := strings.Cut(config.AccessRepo, "/")
+
+	title := fmt.Sprintf("Access request: %s for %s", ar.Login, ar.App)
+	body := accessIssueBody(*ar, nil)
+	callCtx, done := startCall(ctx, "github.issues.create")
+	issue, _, err := client.Issues.Create(callCtx, owner, repo, &github.IssueRequest{Title: &title, Body: &body})
+	done(err)
+	if err != nil {
+		return err
+	}
+
+	// The links carry the issue number so decisions can be recorded on it,
+	// so they're only added once the issue exists
+	ar.Issue = issue.GetNumber()
+	links, err := accessLinks(req, *ar)
+	if err != nil {
+		return err
+	}
+	body = accessIssueBody(*ar, links)
+	callCtx, done = startCall(ctx, "github.issues.edit")
+	_, _, err = client.Issues.Edit(callCtx, owner, repo, ar.Issue, &github.IssueRequest{Body: &body})
+	done(err)
+	return err
+}
+
+func closeAccessIssue(ctx context.Context, ar auth.AccessRequest, eventType, approver string) error {
+	client, err := accessClient(ctx)
+	if err != nil {
+		return err
+	}
+	owner, repo, _ := strings.Cut(config.AccessRepo, "/")
+
+	verb := "approved"
+	if eventType == audit.AccessRejected {
+		verb = "rejected"
+	}
+	comment := fmt.Sprintf("@%s %s this request.", approver, verb)
+	callCtx, done := startCall(ctx, "github.issues.comment")
+	_, _, err = client.Issues.CreateComment(callCtx, owner, repo, ar.Issue, &github.IssueComment{Body: &comment})
+	done(err)
+	if err != nil {
+		return err
+	}
+
+	state := "closed"
+	callCtx, done = startCall(ctx, "github.issues.edit")
+	_, _, err = client.Issues.Edit(callCtx, owner, repo, ar.Issue, &github.IssueRequest{State: &state})
+	done(err)
+	return err
+}
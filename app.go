@@ -0,0 +1,379 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	appJWTLifetime = 9 * time.Minute
+	appTokenMargin = time.Minute
+)
+
+type installationToken struct {
+	token   string
+	expires time.Time
+}
+
+var (
+	appKey            *rsa.PrivateKey
+	appTokens         = map[int64]installationToken{}
+	appInstallations  = map[string]int64{}
+	appTokensMutex    sync.Mutex
+	appInstallsLoaded time.Time
+)
+
+func parseAppKey(raw string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(raw))
+	if block == nil {
+		return nil, fmt.Errorf("app private key is not valid PEM")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("app private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// appJWT builds the RS256-signed JWT used to authenticate as the GitHub App
+func appJWT() (string, error) {
+	now := time.Now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		// Backdated to allow for clock drift, per GitHub's recommendation
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": strconv.FormatInt(config.AppID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, appKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
+
+func tokenClient(token string) *github.Client {
+	return github.NewClient(&http.Client{
+		Transport: &oauth2.Transport{
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token, TokenType: "Bearer"}),
+			Base:   httpClient.Transport,
+		},
+	})
+}
+
+func appClient() (*github.Client, error) {
+	jwt, err := appJWT()
+	if err != nil {
+		return nil, err
+	}
+	return tokenClient(jwt), nil
+}
+
+// appInstallationIDs maps org names to the app's installation IDs, refreshing
+// the list at most once per teamCacheTTL
+func appInstallationIDs(ctx context.Context) (map[string]int64, error) {
+	appTokensMutex.Lock()
+	installs, loaded := appInstallations, appInstallsLoaded
+	appTokensMutex.Unlock()
+	if time.Since(loaded) < teamCacheTTL {
+		return installs, nil
+	}
+
+	client, err := appClient()
+	if err != nil {
+		return nil, err
+	}
+	installs = map[string]int64{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		callCtx, done := startCall(ctx, "github.apps.installations")
+		page, resp, err := client.Apps.ListInstallations(callCtx, opts)
+		done(err)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range page {
+			if i.GetTargetType() == "Organization" {
+				installs[i.GetAccount().GetLogin()] = i.GetID()
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	appTokensMutex.Lock()
+	appInstallations = installs
+	appInstallsLoaded = time.Now()
+	appTokensMutex.Unlock()
+	return installs, nil
+}
+
+// installationClient returns a client authenticated as the app's installation,
+// reusing installation tokens until shortly before they expire
+func installationClient(ctx context.Context, installID int64) (*github.Client, error) {
+	appTokensMutex.Lock()
+	cached, ok := appTokens[installID]
+	appTokensMutex.Unlock()
+	if ok && time.Until(cached.expires) > appTokenMargin {
+		return tokenClient(cached.token), nil
+	}
+
+	client, err := appClient()
+	if err != nil {
+		return nil, err
+	}
+	callCtx, done := startCall(ctx, "github.apps.token")
+	token, _, err := client.Apps.CreateInstallationToken(callCtx, installID)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	appTokensMutex.Lock()
+	appTokens[installID] = installationToken{token: token.GetToken(), expires: token.GetExpiresAt()}
+	appTokensMutex.Unlock()
+	return tokenClient(token.GetToken()), nil
+}
+
+// collectAppMemberships resolves the user's org and team memberships using
+// the app's installation tokens, so the user's own token needs no scopes
+func collectAppMemberships(ctx context.Context, login string, id *identity) error {
+	installs, err := appInstallationIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing app installations: %s", err)
+	}
+
+	var mutex sync.Mutex
+	id.TeamRoles = map[int64]string{}
+	g, ctx := errgroup.WithContext(ctx)
+	for org, installID := range installs {
+		if len(config.AppOrgs) > 0 && !containsFold(config.AppOrgs, org) {
+			continue
+		}
+		org, installID := org, installID
+		g.Go(func() error {
+			client, err := installationClient(ctx, installID)
+			if err != nil {
+				return fmt.Errorf("error getting installation token for %s: %s", org, err)
+			}
+			m, err := orgMembership(ctx, client, org, login)
+			if err != nil || m == nil {
+				return err
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			id.Orgs = append(id.Orgs, m.membership)
+			id.Teams = append(id.Teams, m.teams...)
+			id.InheritedTeams = append(id.InheritedTeams, m.inherited...)
+			for teamID, role := range m.roles {
+				id.TeamRoles[teamID] = role
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+type appOrgMembership struct {
+	membership *github.Membership
+	teams      []*github.Team
+	inherited  []*github.Team
+	roles      map[int64]string
+}
+
+func isNotFound(err error) bool {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.StatusCode == http.StatusNotFound
+}
+
+// orgMembership looks up the user's membership of a single org and its teams,
+// returning nil if they aren't an active member
+func orgMembership(ctx context.Context, client *github.Client, org, login string) (*appOrgMembership, error) {
+	callCtx, done := startCall(ctx, "github.orgs.membership")
+	membership, _, err := client.Organizations.GetOrgMembership(callCtx, login, org)
+	done(err)
+	if isNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting %s membership: %s", org, err)
+	}
+	if membership.GetState() != "active" {
+		return nil, nil
+	}
+
+	result, err := orgTeamMemberships(ctx, client, membership.GetOrganization(), login)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s teams: %s", org, err)
+	}
+	result.membership = membership
+	return result, nil
+}
+
+const orgTeamsQuery = `query($org: String!, $login: String!, $cursor: String) {
+  organization(login: $org) {
+    teams(first: 100, after: $cursor, userLogins: [$login]) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        databaseId
+        slug
+        name
+        members(query: $login, membership: IMMEDIATE, first: 10) {
+          edges { role node { login } }
+        }
+        ancestors(first: 100) {
+          nodes { databaseId slug name }
+        }
+      }
+    }
+  }
+}`
+
+type graphQLTeam struct {
+	DatabaseID int64  `json:"databaseId"`
+	Slug       string `json:"slug"`
+	Name       string `json:"name"`
+}
+
+type orgTeamsResponse struct {
+	Data struct {
+		Organization struct {
+			Teams struct {
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+				Nodes []struct {
+					graphQLTeam
+					Members struct {
+						Edges []struct {
+							Role string `json:"role"`
+							Node struct {
+								Login string `json:"login"`
+							} `json:"node"`
+						} `json:"edges"`
+					} `json:"members"`
+					Ancestors struct {
+						Nodes []graphQLTeam `json:"nodes"`
+					} `json:"ancestors"`
+				} `json:"nodes"`
+			} `json:"teams"`
+		} `json:"organization"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (t graphQLTeam) team(org *github.Organization) *github.Team {
+	return &github.Team{ID: &t.DatabaseID, Slug: &t.Slug, Name: &t.Name, Organization: org}
+}
+
+// orgTeamMemberships finds the user's teams in an org, their role in each, and
+// the teams they inherit through child teams, with one GraphQL query per 100
+// teams the user belongs to rather than a call per team in the org
+func orgTeamMemberships(ctx context.Context, client *github.Client, org *github.Organization, login string) (*appOrgMembership, error) {
+	result := &appOrgMembership{roles: map[int64]string{}}
+	seen := map[int64]bool{}
+	var ancestors []graphQLTeam
+	vars := map[string]interface{}{"org": org.GetLogin(), "login": login}
+	for {
+		req, err := client.NewRequest("POST", "graphql", map[string]interface{}{
+			"query":     orgTeamsQuery,
+			"variables": vars,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var page orgTeamsResponse
+		callCtx, done := startCall(ctx, "github.graphql.teams")
+		resp, err := client.Do(callCtx, req, &page)
+		done(err)
+		if err != nil {
+			return nil, err
+		}
+		recordRate(resp)
+		if len(page.Errors) > 0 {
+			return nil, fmt.Errorf("graphql error: %s", page.Errors[0].Message)
+		}
+
+		teams := page.Data.Organization.Teams
+		for _, node := range teams.Nodes {
+			direct := false
+			for _, edge := range node.Members.Edges {
+				if strings.EqualFold(edge.Node.Login, login) {
+					direct = true
+					result.roles[node.DatabaseID] = strings.ToLower(edge.Role)
+				}
+			}
+			seen[node.DatabaseID] = true
+			if direct {
+				result.teams = append(result.teams, node.team(org))
+			} else {
+				result.inherited = append(result.inherited, node.team(org))
+			}
+			ancestors = append(ancestors, node.Ancestors.Nodes...)
+		}
+		if !teams.PageInfo.HasNextPage {
+			break
+		}
+		vars["cursor"] = teams.PageInfo.EndCursor
+	}
+
+	for _, t := range ancestors {
+		if !seen[t.DatabaseID] {
+			seen[t.DatabaseID] = true
+			result.inherited = append(result.inherited, t.team(org))
+		}
+	}
+	return result, nil
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
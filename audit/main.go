@@ -0,0 +1,197 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/akerl/github-auth-lambda/session"
+
+	"github.com/akerl/go-lambda/apigw/events"
+	"github.com/akerl/go-lambda/s3"
+	"github.com/google/uuid"
+)
+
+// Event types emitted by the auth lambda and SessionCheck
+const (
+	LoginSuccess = "login_success"
+	LoginFailure = "login_failure"
+	LoginDenied  = "login_denied"
+	Revalidated  = "revalidated"
+	Logout       = "logout"
+	ACLDeny      = "acl_deny"
+
+	AccessRequested = "access_requested"
+	AccessApproved  = "access_approved"
+	AccessRejected  = "access_rejected"
+)
+
+// Event describes a single authentication event
+type Event struct {
+	Time      time.Time `json:"time"`
+	Type      string    `json:"type"`
+	RequestID string    `json:"request_id,omitempty"`
+	Host      string    `json:"host,omitempty"`
+	Path      string    `json:"path,omitempty"`
+	Login     string    `json:"login,omitempty"`
+	Teams     []string  `json:"teams,omitempty"`
+	SourceIP  string    `json:"source_ip,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	Target    string    `json:"target,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+// NewEvent builds an Event from a request and the session attached to it
+func NewEvent(eventType string, req events.Request, sess session.Session) Event {
+	userAgent := req.RequestContext.Identity.UserAgent
+	if userAgent == "" {
+		userAgent = req.Headers["User-Agent"]
+	}
+	return Event{
+		Time:      time.Now().UTC(),
+		Type:      eventType,
+		RequestID: req.RequestContext.RequestID,
+		Host:      req.Headers["Host"],
+		Path:      req.Path,
+		Login:     sess.Login,
+		Teams:     teamList(sess),
+		SourceIP:  req.RequestContext.Identity.SourceIP,
+		UserAgent: userAgent,
+		Target:    sess.Target,
+	}
+}
+
+func teamList(sess session.Session) []string {
+	var teams []string
+	for org, om := range sess.Orgs {
+		for slug, tm := range om.Teams {
+			if !tm.Inherited {
+				teams = append(teams, org+"/"+slug)
+			}
+		}
+	}
+	sort.Strings(teams)
+	return teams
+}
+
+// Sink receives audit events
+type Sink interface {
+	Write(Event) error
+}
+
+// Emit writes an event to a sink, falling back to stderr if the sink fails
+func Emit(sink Sink, event Event) {
+	if sink == nil {
+		sink = &StdoutSink{}
+	}
+	err := sink.Write(event)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write audit event: %s\n", err)
+	}
+}
+
+// StdoutSink writes events as JSON lines
+type StdoutSink struct {
+	Writer io.Writer
+}
+
+// Write encodes the event as a single JSON line
+func (s *StdoutSink) Write(event Event) error {
+	w := s.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// Flusher is implemented by sinks that buffer events
+type Flusher interface {
+	Flush() error
+}
+
+// Flush writes any events buffered by the sink. Lambda containers can be
+// frozen or recycled between invocations, so this should be called before
+// each invocation returns.
+func Flush(sink Sink) error {
+	if f, ok := sink.(Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// maxBufferedBatches bounds how many batches S3Sink holds while S3 is failing
+// before it falls back to writing events to stderr
+const maxBufferedBatches = 10
+
+// S3Sink buffers events and writes them to S3 as JSON lines objects. Buffered
+// events are lost if the container is recycled, so callers should Flush the
+// sink at the end of each invocation.
+type S3Sink struct {
+	Bucket    string
+	Prefix    string
+	BatchSize int
+	MaxAge    time.Duration
+	buffer    []Event
+	mutex     sync.Mutex
+}
+
+// Write adds the event to the buffer, flushing it if it is full or stale
+func (s *S3Sink) Write(event Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.buffer = append(s.buffer, event)
+	if len(s.buffer) < s.BatchSize && time.Since(s.buffer[0].Time) < s.MaxAge {
+		return nil
+	}
+	return s.flush()
+}
+
+// Flush writes any buffered events to S3
+func (s *S3Sink) Flush() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.flush()
+}
+
+func (s *S3Sink) flush() error {
+	if len(s.buffer) == 0 {
+		return nil
+	}
+	var body []byte
+	for _, event := range s.buffer {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		body = append(body, data...)
+		body = append(body, '\n')
+	}
+	key := fmt.Sprintf(
+		"%s%s-%s.jsonl",
+		s.Prefix,
+		s.buffer[0].Time.Format("2006/01/02/150405"),
+		uuid.NewString(),
+	)
+	err := s3.PutObject(s.Bucket, key, string(body))
+	if err != nil {
+		if len(s.buffer) >= maxBufferedBatches*s.BatchSize {
+			fallback := &StdoutSink{Writer: os.Stderr}
+			for _, event := range s.buffer {
+				_ = fallback.Write(event)
+			}
+			s.buffer = nil
+		}
+		return err
+	}
+	s.buffer = nil
+	return nil
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AccessRequestTTL is how long a signed AccessRequest is accepted, which
+// leaves approvers time to act on the links they're sent
+const AccessRequestTTL = 7 * 24 * time.Hour
+
+// DenialPurpose is the purpose SessionCheck signs denials for when linking
+// to the request access page
+const DenialPurpose = "denied"
+
+// AccessRequest describes access a user was denied or has asked for. Requests
+// aren't stored: they travel signed, first by SessionCheck into the request
+// access link, and then by the auth lambda into the links sent to approvers.
+type AccessRequest struct {
+	ID      string   `json:"id,omitempty"`
+	Login   string   `json:"login"`
+	UserID  int64    `json:"user_id,omitempty"`
+	App     string   `json:"app"`
+	Target  string   `json:"target,omitempty"`
+	Need    []string `json:"need,omitempty"`
+	Reason  string   `json:"reason,omitempty"`
+	Issue   int      `json:"issue,omitempty"`
+	Created int64    `json:"created"`
+}
+
+// accessRequestSig signs a payload for a purpose, so a signature for one use
+// can't be replayed for another
+func accessRequestSig(key []byte, purpose, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("access-request:" + purpose + ":" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// EncodeAccessRequest encodes an AccessRequest and signs it for a purpose
+func EncodeAccessRequest(key []byte, purpose string, ar AccessRequest) (string, string, error) {
+	data, err := json.Marshal(ar)
+	if err != nil {
+		return "", "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload, accessRequestSig(key, purpose, payload), nil
+}
+
+// DecodeAccessRequest checks the signature and age of an encoded AccessRequest
+func DecodeAccessRequest(key []byte, purpose, payload, sig string) (AccessRequest, error) {
+	var ar AccessRequest
+	if !hmac.Equal([]byte(sig), []byte(accessRequestSig(key, purpose, payload))) {
+		return ar, fmt.Errorf("invalid signature")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return ar, err
+	}
+	err = json.Unmarshal(data, &ar)
+	if err != nil {
+		return ar, err
+	}
+	if time.Since(time.Unix(ar.Created, 0)) > AccessRequestTTL {
+		return ar, fmt.Errorf("access request has expired")
+	}
+	return ar, nil
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecodeAccessRequest(t *testing.T) {
+	key := []byte("sign-key")
+	fresh := AccessRequest{Login: "alice", App: "app.example.com", Need: []string{"member of team org/dev"}, Created: time.Now().Unix()}
+	expired := fresh
+	expired.Created = time.Now().Add(-AccessRequestTTL - time.Minute).Unix()
+
+	freshPayload, freshSig, err := EncodeAccessRequest(key, "approve", fresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherSig, err := EncodeAccessRequest(key, "reject", fresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiredPayload, expiredSig, err := EncodeAccessRequest(key, "approve", expired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		key     []byte
+		payload string
+		sig     string
+		wantErr bool
+	}{
+		{"valid", key, freshPayload, freshSig, false},
+		{"wrong key", []byte("other-key"), freshPayload, freshSig, true},
+		{"signed for another purpose", key, freshPayload, otherSig, true},
+		{"tampered payload", key, freshPayload + "x", freshSig, true},
+		{"missing signature", key, freshPayload, "", true},
+		{"expired", key, expiredPayload, expiredSig, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ar, err := DecodeAccessRequest(c.key, "approve", c.payload, c.sig)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if ar.Login != fresh.Login || ar.App != fresh.App || len(ar.Need) != 1 {
+				t.Errorf("decoded %+v, want %+v", ar, fresh)
+			}
+		})
+	}
+}
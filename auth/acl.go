@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/akerl/github-auth-lambda/session"
+
+	"github.com/akerl/go-lambda/apigw/events"
+)
+
+// Rule lists users, orgs, teams, repo permissions, and roles (named as
+// "app/role") that are granted access, optionally only while an Expression
+// holds. A rule with only an Expression applies to any logged in user. Since
+// logins, org names, and team slugs can be renamed, the ID fields should be
+// preferred. Contact and Description are shown to denied users, as who to ask
+// for access and in place of the Expression.
+type Rule struct {
+	Name        string            `json:"name"`
+	Logins      []string          `json:"logins"`
+	UserIDs     []int64           `json:"user_ids"`
+	Orgs        []string          `json:"orgs"`
+	OrgIDs      []int64           `json:"org_ids"`
+	Teams       []string          `json:"teams"`
+	TeamIDs     []int64           `json:"team_ids"`
+	Inherited   bool              `json:"inherited"`
+	Repos       []RepoRequirement `json:"repos"`
+	Roles       []string          `json:"roles"`
+	Expression  string            `json:"expression"`
+	Contact     string            `json:"contact"`
+	Description string            `json:"description"`
+}
+
+// Empty checks if the rule has neither principals nor an expression, and so
+// can never match
+func (r Rule) Empty() bool {
+	return !r.hasPrincipals() && r.Expression == ""
+}
+
+func (r Rule) hasPrincipals() bool {
+	return len(r.Logins)+len(r.UserIDs)+len(r.Orgs)+len(r.OrgIDs)+
+		len(r.Teams)+len(r.TeamIDs)+len(r.Repos)+len(r.Roles) > 0
+}
+
+// Matches checks if the session matches any of the principals on the rule,
+// and that the rule's expression, if any, holds for the request
+func (r Rule) Matches(req events.Request, sess session.Session) (bool, error) {
+	return r.matches(sess, func() (bool, error) {
+		return EvalExpression(r.Expression, req, sess)
+	})
+}
+
+func (r Rule) matchesRole(sess session.Session) (bool, error) {
+	return r.matches(sess, func() (bool, error) {
+		return evalRoleExpression(r.Expression, sess)
+	})
+}
+
+func (r Rule) matches(sess session.Session, eval func() (bool, error)) (bool, error) {
+	if sess.Login == "" {
+		return false, nil
+	}
+	if r.hasPrincipals() && !r.matchesPrincipal(sess) {
+		return false, nil
+	}
+	if r.Expression == "" {
+		return r.hasPrincipals(), nil
+	}
+	return eval()
+}
+
+func (r Rule) matchesPrincipal(sess session.Session) bool {
+	for _, login := range r.Logins {
+		if strings.EqualFold(login, sess.Login) {
+			return true
+		}
+	}
+	for _, id := range r.UserIDs {
+		if sess.UserID != 0 && id == sess.UserID {
+			return true
+		}
+	}
+	for _, org := range r.Orgs {
+		if _, ok := sess.Orgs[org]; ok {
+			return true
+		}
+	}
+	for _, id := range r.OrgIDs {
+		if _, ok := sess.OrgByID(id); ok {
+			return true
+		}
+	}
+	for _, team := range r.Teams {
+		org, slug, _ := strings.Cut(team, "/")
+		if sess.InTeam(org, slug) || (r.Inherited && sess.InTeamOrChild(org, slug)) {
+			return true
+		}
+	}
+	for _, id := range r.TeamIDs {
+		if _, _, tm, ok := sess.TeamByID(id); ok && (r.Inherited || !tm.Inherited) {
+			return true
+		}
+	}
+	for _, repo := range r.Repos {
+		if sess.HasRepoPermission(repo.Repo, repo.Permission) {
+			return true
+		}
+	}
+	for _, role := range r.Roles {
+		app, name, _ := strings.Cut(role, "/")
+		if sess.HasRole(app, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeRoles evaluates a mapping of application namespaces to role names to
+// rules, returning the roles the session is granted in each namespace. Roles
+// last as long as the session, so their expressions can only reference the
+// session; request and time based conditions belong in SessionCheck rules.
+func ComputeRoles(mapping map[string]map[string]Rule, sess session.Session) (map[string][]string, error) {
+	// Roles can't be granted based on other roles, including stale ones
+	// from before the session was revalidated
+	sess.Roles = nil
+	roles := map[string][]string{}
+	for app, appRoles := range mapping {
+		for name, rule := range appRoles {
+			match, err := rule.matchesRole(sess)
+			if err != nil {
+				return nil, err
+			}
+			if match {
+				roles[app] = append(roles[app], name)
+			}
+		}
+		sort.Strings(roles[app])
+	}
+	return roles, nil
+}
+
+// RequireRole returns a SessionCheck.ACLHandler which allows sessions holding
+// any of the given roles within an application's namespace
+func RequireRole(app string, roles ...string) func(events.Request, session.Session) (bool, error) {
+	return func(_ events.Request, sess session.Session) (bool, error) {
+		for _, role := range roles {
+			if sess.HasRole(app, role) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// RuleACL returns a SessionCheck.ACLHandler which allows sessions matching any
+// of the rules
+func RuleACL(rules ...Rule) func(events.Request, session.Session) (bool, error) {
+	decide := RuleDecision(rules...)
+	return func(req events.Request, sess session.Session) (bool, error) {
+		d, err := decide(req, sess)
+		return d.Allowed, err
+	}
+}
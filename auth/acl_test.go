@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/akerl/github-auth-lambda/session"
+)
+
+func testSession() session.Session {
+	return session.Session{
+		Login:  "alice",
+		UserID: 1,
+		Orgs: map[string]session.OrgMembership{
+			"org": {ID: 10, Role: session.RoleMember, Teams: map[string]session.TeamMembership{
+				"sre": {ID: 7, Role: session.RoleMaintainer},
+				"eng": {ID: 8, Role: session.RoleMember, Inherited: true},
+			}},
+		},
+		RepoPermissions: map[string]string{"org/app": "write"},
+		Roles:           map[string][]string{"app": {"stale"}},
+	}
+}
+
+func TestComputeRoles(t *testing.T) {
+	mapping := map[string]map[string]Rule{
+		"app": {
+			"admin":     {Teams: []string{"org/sre"}},
+			"viewer":    {OrgIDs: []int64{10}},
+			"parent":    {Teams: []string{"org/eng"}, Inherited: true},
+			"direct":    {Teams: []string{"org/eng"}},
+			"from-role": {Roles: []string{"app/stale"}},
+			"nobody":    {Logins: []string{"bob"}},
+			"empty":     {},
+		},
+		"other": {
+			"editor": {Repos: []RepoRequirement{{Repo: "org/app", Permission: "write"}}},
+		},
+		"unused": {
+			"admin": {UserIDs: []int64{2}},
+		},
+	}
+
+	roles, err := ComputeRoles(mapping, testSession())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"app":   {"admin", "parent", "viewer"},
+		"other": {"editor"},
+	}
+	if !reflect.DeepEqual(roles, want) {
+		t.Errorf("ComputeRoles = %v, want %v", roles, want)
+	}
+
+	roles, err = ComputeRoles(mapping, session.Session{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) > 0 {
+		t.Errorf("logged out session granted roles %v", roles)
+	}
+}
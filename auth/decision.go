@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/akerl/github-auth-lambda/session"
+
+	"github.com/akerl/go-lambda/apigw/events"
+)
+
+// Decision describes the outcome of an ACL evaluation. Rule names the rule
+// that granted access; on denial, Missing lists the requirements of each rule
+// that would have granted it, Contacts lists who can grant them, and
+// RequestURL links to a page for requesting access.
+type Decision struct {
+	Allowed    bool
+	Rule       string
+	Missing    []string
+	Contacts   []string
+	RequestURL string
+}
+
+// Requirements describes what a session needs in order to match the rule
+func (r Rule) Requirements() []string {
+	var reqs []string
+	for _, login := range r.Logins {
+		reqs = append(reqs, "user "+login)
+	}
+	for _, id := range r.UserIDs {
+		reqs = append(reqs, fmt.Sprintf("user ID %d", id))
+	}
+	for _, org := range r.Orgs {
+		reqs = append(reqs, "member of org "+org)
+	}
+	for _, id := range r.OrgIDs {
+		reqs = append(reqs, fmt.Sprintf("member of org ID %d", id))
+	}
+	teamPrefix := "member of team "
+	if r.Inherited {
+		teamPrefix = "member of team (or a child of) "
+	}
+	for _, team := range r.Teams {
+		reqs = append(reqs, teamPrefix+team)
+	}
+	for _, id := range r.TeamIDs {
+		reqs = append(reqs, fmt.Sprintf("%steam ID %d", teamPrefix, id))
+	}
+	for _, repo := range r.Repos {
+		reqs = append(reqs, fmt.Sprintf("%s access to %s", repo.Permission, repo.Repo))
+	}
+	for _, role := range r.Roles {
+		reqs = append(reqs, "role "+role)
+	}
+	return reqs
+}
+
+// missing describes the parts of the rule the session failed to satisfy
+func (r Rule) missing(sess session.Session) string {
+	var parts []string
+	if r.hasPrincipals() && !r.matchesPrincipal(sess) {
+		parts = append(parts, strings.Join(r.Requirements(), " or "))
+	}
+	// Expressions describe policy internals, so they're summarized by the
+	// rule's description or name instead
+	if r.Expression != "" {
+		switch {
+		case r.Description != "":
+			parts = append(parts, r.Description)
+		case r.Name != "":
+			parts = append(parts, "the conditions of rule "+r.Name)
+		default:
+			parts = append(parts, "additional conditions")
+		}
+	}
+	return strings.Join(parts, ", and ")
+}
+
+// RuleDecision returns a SessionCheck.DecisionHandler which allows sessions matching any of
+// the rules, reporting what each rule requires when none match
+func RuleDecision(rules ...Rule) func(events.Request, session.Session) (Decision, error) {
+	return func(req events.Request, sess session.Session) (Decision, error) {
+		d := Decision{}
+		contacts := map[string]bool{}
+		for _, r := range rules {
+			match, err := r.Matches(req, sess)
+			if err != nil {
+				return Decision{}, err
+			}
+			if match {
+				return Decision{Allowed: true, Rule: r.Name}, nil
+			}
+			if m := r.missing(sess); m != "" {
+				d.Missing = append(d.Missing, m)
+			}
+			if r.Contact != "" && !contacts[r.Contact] {
+				contacts[r.Contact] = true
+				d.Contacts = append(d.Contacts, r.Contact)
+			}
+		}
+		sort.Strings(d.Contacts)
+		return d, nil
+	}
+}
+
+// ACLDecision adapts a SessionCheck.ACLHandler for use as a DecisionHandler
+func ACLDecision(handler func(events.Request, session.Session) (bool, error)) func(events.Request, session.Session) (Decision, error) {
+	return func(req events.Request, sess session.Session) (Decision, error) {
+		allowed, err := handler(req, sess)
+		return Decision{Allowed: allowed}, err
+	}
+}
+
+var deniedTemplate = template.Must(template.New("denied").Parse(`<!DOCTYPE html>
+<html>
+    <head>
+        <meta charset="utf-8">
+        <title>Not authorized</title>
+    </head>
+    <body>
+        <h1>Not authorized</h1>
+        <p>You're signed in as {{ .Login }}, but that account doesn't have access to this page.</p>
+        {{- if .Missing }}
+        <p>Access requires one of the following:</p>
+        <ul>
+            {{- range .Missing }}
+            <li>{{ . }}</li>
+            {{- end }}
+        </ul>
+        {{- end }}
+        {{- if .Contacts }}
+        <p>To request access, contact {{ range $i, $c := .Contacts }}{{ if $i }}, {{ end }}{{ $c }}{{ end }}.</p>
+        {{- end }}
+        {{- if .RequestURL }}
+        <p><a href="{{ .RequestURL }}">Request access</a></p>
+        {{- end }}
+    </body>
+</html>
+`))
+
+// DeniedPage renders the default 403 page for a denied decision
+func DeniedPage(_ events.Request, sess session.Session, d Decision) (events.Response, error) {
+	var buf bytes.Buffer
+	err := deniedTemplate.Execute(&buf, map[string]interface{}{
+		"Login":      sess.Login,
+		"Missing":    d.Missing,
+		"Contacts":   d.Contacts,
+		"RequestURL": d.RequestURL,
+	})
+	if err != nil {
+		return events.Reject("Not authorized")
+	}
+	return events.Response{
+		StatusCode: 403,
+		Body:       buf.String(),
+		Headers: map[string]string{
+			"Content-Type": "text/html; charset=utf-8",
+		},
+	}, nil
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/akerl/go-lambda/apigw/events"
+)
+
+func TestRuleDecision(t *testing.T) {
+	rules := []Rule{
+		{Name: "sre", Teams: []string{"org/ops"}, Logins: []string{"bob"}, Contact: "#ops"},
+		{Name: "writes", Orgs: []string{"org"}, Expression: `request.method == "POST"`, Contact: "#ops"},
+		{Name: "vpn", Expression: `request.headers["x-vpn"] == "1"`, Description: "connected to the VPN", Contact: "#it"},
+		{Teams: []string{"org/dev"}, Expression: `request.method == "PUT"`},
+	}
+	decide := RuleDecision(rules...)
+	req := events.Request{HTTPMethod: "GET", Headers: map[string]string{"X-VPN": "0"}}
+
+	d, err := decide(req, testSession())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Decision{
+		Missing: []string{
+			"user bob or member of team org/ops",
+			"the conditions of rule writes",
+			"connected to the VPN",
+			"member of team org/dev, and additional conditions",
+		},
+		Contacts: []string{"#it", "#ops"},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("decision = %+v, want %+v", d, want)
+	}
+	for _, m := range d.Missing {
+		if strings.Contains(m, "request.") {
+			t.Errorf("expression leaked into the decision: %s", m)
+		}
+	}
+
+	req.HTTPMethod = "POST"
+	d, err = decide(req, testSession())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Allowed || d.Rule != "writes" || len(d.Missing) > 0 {
+		t.Errorf("decision = %+v, want allowed by writes", d)
+	}
+}
@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/akerl/github-auth-lambda/session"
+
+	"github.com/akerl/go-lambda/apigw/events"
+	"github.com/ghodss/yaml"
+	"github.com/google/cel-go/cel"
+)
+
+// exprEnv is a CEL environment along with the programs compiled in it
+type exprEnv struct {
+	options  []cel.EnvOption
+	env      *cel.Env
+	err      error
+	once     sync.Once
+	programs map[string]cel.Program
+	mutex    sync.RWMutex
+}
+
+var (
+	requestEnv = &exprEnv{options: []cel.EnvOption{
+		cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("session", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("now", cel.TimestampType),
+	}}
+	// Roles are computed once at login and stored for the session's
+	// lifetime, so role expressions can only depend on the session
+	sessionEnv = &exprEnv{options: []cel.EnvOption{
+		cel.Variable("session", cel.MapType(cel.StringType, cel.DynType)),
+	}}
+)
+
+func (e *exprEnv) compile(source string) (cel.Program, error) {
+	e.once.Do(func() {
+		e.env, e.err = cel.NewEnv(e.options...)
+		e.programs = map[string]cel.Program{}
+	})
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	e.mutex.RLock()
+	program, ok := e.programs[source]
+	e.mutex.RUnlock()
+	if ok {
+		return program, nil
+	}
+
+	ast, issues := e.env.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must return a bool: %s", source)
+	}
+	program, err := e.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mutex.Lock()
+	e.programs[source] = program
+	e.mutex.Unlock()
+	return program, nil
+}
+
+func (e *exprEnv) eval(source string, vars map[string]interface{}) (bool, error) {
+	program, err := e.compile(source)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression did not return a bool: %s", source)
+	}
+	return result, nil
+}
+
+// CompileExpression parses and type-checks a CEL expression, caching the
+// compiled program for later evaluations. Expressions can reference:
+//
+//	request: method, path, host, source_ip, user_agent, headers, query
+//	session: login, user_id, email, domain_email, orgs, teams, all_teams,
+//	         org_roles, team_roles, roles, repos
+//	now: the current time
+//
+// Headers are keyed by lowercase name, teams are named as "org/team", and
+// roles as "app/role". For example:
+//
+//	"org/sre" in session.teams ||
+//	  ("org/dev" in session.teams && request.method != "DELETE" &&
+//	   now.getHours("America/New_York") >= 9 && now.getHours("America/New_York") < 17)
+func CompileExpression(source string) (cel.Program, error) {
+	return requestEnv.compile(source)
+}
+
+// CompileRoleExpression compiles an expression used in a role mapping, which
+// can only reference session
+func CompileRoleExpression(source string) (cel.Program, error) {
+	return sessionEnv.compile(source)
+}
+
+// EvalExpression evaluates a CEL expression against a request and session
+func EvalExpression(source string, req events.Request, sess session.Session) (bool, error) {
+	return requestEnv.eval(source, map[string]interface{}{
+		"request": requestVars(req),
+		"session": sessionVars(sess),
+		"now":     time.Now(),
+	})
+}
+
+func evalRoleExpression(source string, sess session.Session) (bool, error) {
+	return sessionEnv.eval(source, map[string]interface{}{
+		"session": sessionVars(sess),
+	})
+}
+
+func requestVars(req events.Request) map[string]interface{} {
+	headers := map[string]string{}
+	for k, v := range req.Headers {
+		headers[strings.ToLower(k)] = v
+	}
+	query := req.QueryStringParameters
+	if query == nil {
+		query = map[string]string{}
+	}
+	return map[string]interface{}{
+		"method":     req.HTTPMethod,
+		"path":       req.Path,
+		"host":       req.Headers["Host"],
+		"source_ip":  req.RequestContext.Identity.SourceIP,
+		"user_agent": req.RequestContext.Identity.UserAgent,
+		"headers":    headers,
+		"query":      query,
+	}
+}
+
+func sessionVars(sess session.Session) map[string]interface{} {
+	orgs := []string{}
+	orgRoles := map[string]string{}
+	teams := []string{}
+	allTeams := []string{}
+	teamRoles := map[string]string{}
+	for org, om := range sess.Orgs {
+		orgs = append(orgs, org)
+		orgRoles[org] = om.Role
+		for slug, tm := range om.Teams {
+			name := org + "/" + slug
+			allTeams = append(allTeams, name)
+			if !tm.Inherited {
+				teams = append(teams, name)
+				teamRoles[name] = tm.Role
+			}
+		}
+	}
+	roles := []string{}
+	for app, names := range sess.Roles {
+		for _, name := range names {
+			roles = append(roles, app+"/"+name)
+		}
+	}
+	repos := sess.RepoPermissions
+	if repos == nil {
+		repos = map[string]string{}
+	}
+	for _, list := range [][]string{orgs, teams, allTeams, roles} {
+		sort.Strings(list)
+	}
+
+	return map[string]interface{}{
+		"login":        sess.Login,
+		"user_id":      sess.UserID,
+		"email":        sess.Email,
+		"domain_email": sess.DomainEmail,
+		"orgs":         orgs,
+		"teams":        teams,
+		"all_teams":    allTeams,
+		"org_roles":    orgRoles,
+		"team_roles":   teamRoles,
+		"roles":        roles,
+		"repos":        repos,
+	}
+}
+
+// LoadRules parses a YAML or JSON list of rules, compiling any expressions so
+// that mistakes are caught up front
+func LoadRules(data []byte) ([]Rule, error) {
+	var rules []Rule
+	err := yaml.Unmarshal(data, &rules)
+	if err != nil {
+		return nil, err
+	}
+	err = CompileRules(rules)
+	return rules, err
+}
+
+// CompileRules compiles the expressions on a set of rules
+func CompileRules(rules []Rule) error {
+	return compileRules(requestEnv, rules)
+}
+
+// CompileRoleRules compiles the expressions on a set of role mapping rules
+func CompileRoleRules(rules []Rule) error {
+	return compileRules(sessionEnv, rules)
+}
+
+func compileRules(e *exprEnv, rules []Rule) error {
+	for _, r := range rules {
+		if r.Expression == "" {
+			continue
+		}
+		if _, err := e.compile(r.Expression); err != nil {
+			return fmt.Errorf("invalid expression on rule %s: %s", r.Name, err)
+		}
+	}
+	return nil
+}
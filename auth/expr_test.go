@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/akerl/go-lambda/apigw/events"
+)
+
+func TestEvalExpression(t *testing.T) {
+	req := events.Request{
+		HTTPMethod:            "GET",
+		Path:                  "/admin",
+		Headers:               map[string]string{"Host": "app.example.com", "X-Team": "sre"},
+		QueryStringParameters: map[string]string{"debug": "1"},
+	}
+	sess := testSession()
+
+	cases := []struct {
+		expr    string
+		want    bool
+		wantErr bool
+	}{
+		{expr: `session.login == "alice" && session.user_id == 1`, want: true},
+		{expr: `"org/sre" in session.teams`, want: true},
+		{expr: `"org/eng" in session.teams`, want: false},
+		{expr: `"org/eng" in session.all_teams`, want: true},
+		{expr: `session.team_roles["org/sre"] == "maintainer"`, want: true},
+		{expr: `session.org_roles["org"] == "admin"`, want: false},
+		{expr: `session.repos["org/app"] == "write"`, want: true},
+		{expr: `"app/stale" in session.roles`, want: true},
+		{expr: `request.method == "GET" && request.path.startsWith("/admin")`, want: true},
+		{expr: `request.host == "app.example.com" && request.headers["x-team"] == "sre"`, want: true},
+		{expr: `request.query["debug"] == "1"`, want: true},
+		{expr: `now > timestamp("2020-01-01T00:00:00Z")`, want: true},
+		{expr: `session.login`, wantErr: true},
+		{expr: `session.login ==`, wantErr: true},
+		{expr: `request.headers["missing"] == "x"`, wantErr: true},
+	}
+	for _, c := range cases {
+		got, err := EvalExpression(c.expr, req, sess)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", c.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.expr, err)
+		} else if got != c.want {
+			t.Errorf("%s = %v, want %v", c.expr, got, c.want)
+		}
+	}
+}
+
+func TestRuleExpressions(t *testing.T) {
+	get := events.Request{HTTPMethod: "GET"}
+	post := events.Request{HTTPMethod: "POST"}
+	cases := []struct {
+		name string
+		rule Rule
+		req  events.Request
+		want bool
+	}{
+		{"expression only", Rule{Expression: `request.method == "GET"`}, get, true},
+		{"expression fails", Rule{Expression: `request.method == "GET"`}, post, false},
+		{"principal and expression", Rule{Teams: []string{"org/sre"}, Expression: `request.method == "GET"`}, get, true},
+		{"principal fails", Rule{Teams: []string{"org/ops"}, Expression: `request.method == "GET"`}, get, false},
+	}
+	for _, c := range cases {
+		got, err := c.rule.Matches(c.req, testSession())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		} else if got != c.want {
+			t.Errorf("%s: Matches = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRoleExpressions(t *testing.T) {
+	mapping := map[string]map[string]Rule{
+		"app": {
+			"on-call":  {Expression: `"org/sre" in session.teams`},
+			"engineer": {Orgs: []string{"org"}, Expression: `"org/eng" in session.teams`},
+		},
+	}
+	roles, err := ComputeRoles(mapping, testSession())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles["app"]) != 1 || roles["app"][0] != "on-call" {
+		t.Errorf("ComputeRoles = %v, want app/on-call", roles)
+	}
+
+	mapping["app"]["bad"] = Rule{Expression: `request.method == "GET"`}
+	if _, err := ComputeRoles(mapping, testSession()); err == nil {
+		t.Errorf("expected an error for a role expression referencing request")
+	}
+}
+
+func TestCompileRules(t *testing.T) {
+	cases := []struct {
+		name       string
+		expr       string
+		requestErr bool
+		roleErr    bool
+	}{
+		{"session only", `"org/sre" in session.teams`, false, false},
+		{"request", `request.method == "GET"`, false, true},
+		{"time", `now.getHours() < 17`, false, true},
+		{"not a bool", `session.login`, true, true},
+		{"unknown variable", `user.login == "alice"`, true, true},
+	}
+	for _, c := range cases {
+		rules := []Rule{{Name: "test"}, {Name: c.name, Expression: c.expr}}
+		err := CompileRules(rules)
+		if (err != nil) != c.requestErr {
+			t.Errorf("%s: CompileRules error = %v, want error %v", c.name, err, c.requestErr)
+		}
+		if err != nil && !strings.Contains(err.Error(), c.name) {
+			t.Errorf("%s: error doesn't name the rule: %s", c.name, err)
+		}
+		err = CompileRoleRules(rules)
+		if (err != nil) != c.roleErr {
+			t.Errorf("%s: CompileRoleRules error = %v, want error %v", c.name, err, c.roleErr)
+		}
+	}
+}
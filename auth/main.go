@@ -1,8 +1,14 @@
 package auth
 
 import (
+	"context"
+	"log/slog"
 	"net/url"
+	"strings"
+	"time"
 
+	"github.com/akerl/github-auth-lambda/audit"
+	"github.com/akerl/github-auth-lambda/metrics"
 	"github.com/akerl/github-auth-lambda/session"
 
 	"github.com/akerl/go-lambda/apigw/events"
@@ -13,6 +19,133 @@ type SessionCheck struct {
 	SessionManager session.Manager
 	AuthURL        string
 	ACLHandler     func(events.Request, session.Session) (bool, error)
+	Rules          []Rule
+	AuditSink      audit.Sink
+	// DecisionHandler takes precedence over ACLHandler and Rules, and can
+	// explain denials to the user via DeniedPage
+	DecisionHandler func(events.Request, session.Session) (Decision, error)
+	DeniedPage      func(events.Request, session.Session, Decision) (events.Response, error)
+	// Contact is shown on the denied page when no rule names its own
+	Contact string
+	// RequestAccessURL points denied users at the auth lambda's /access page,
+	// passing the denial signed with the SessionManager's SignKey
+	RequestAccessURL string
+	// MaxAge sends sessions back through AuthURL to be revalidated once they
+	// were last validated more than MaxAge seconds ago
+	MaxAge int
+	// RepoPermissionFunc resolves repo permissions needed by Rules that
+	// weren't captured at login, such as ClientRepoPermission. All lookups
+	// for a request share a RepoTimeout deadline.
+	RepoPermissionFunc func(context.Context, session.Session, string) (string, error)
+	RepoCacheTTL       time.Duration
+	RepoTimeout        time.Duration
+	repoCache          repoCache
+	// ShadowACLHandler or ShadowRules define a candidate policy that is
+	// evaluated alongside the enforcing one without affecting the result.
+	// Disagreements are logged to Logger and counted on Metrics, which the
+	// caller is responsible for flushing at the end of each request.
+	ShadowACLHandler func(events.Request, session.Session) (bool, error)
+	ShadowRules      []Rule
+	Logger           *slog.Logger
+	Metrics          *metrics.Recorder
+}
+
+func (sc *SessionCheck) logger() *slog.Logger {
+	if sc.Logger == nil {
+		return slog.Default()
+	}
+	return sc.Logger
+}
+
+func (sc *SessionCheck) decisionHandler() func(events.Request, session.Session) (Decision, error) {
+	if sc.DecisionHandler != nil {
+		return sc.DecisionHandler
+	}
+	if sc.ACLHandler != nil {
+		return ACLDecision(sc.ACLHandler)
+	}
+	return RuleDecision(sc.Rules...)
+}
+
+func (sc *SessionCheck) requestURL(req events.Request, sess session.Session, d Decision) (string, error) {
+	accessURL, err := url.Parse(sc.RequestAccessURL)
+	if err != nil {
+		return "", err
+	}
+	target := url.URL{
+		Host:   req.Headers["Host"],
+		Path:   req.Path,
+		Scheme: "https",
+	}
+	payload, sig, err := EncodeAccessRequest(sc.SessionManager.SignKey, DenialPurpose, AccessRequest{
+		Login:   sess.Login,
+		App:     req.Headers["Host"],
+		Target:  target.String(),
+		Need:    d.Missing,
+		Created: time.Now().Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	values := accessURL.Query()
+	values.Set("request", payload)
+	values.Set("sig", sig)
+	accessURL.RawQuery = values.Encode()
+	return accessURL.String(), nil
+}
+
+func (sc *SessionCheck) logDecision(req events.Request, sess session.Session, d Decision) {
+	log := sc.logger().With(
+		"login", sess.Login,
+		"path", req.Path,
+		"method", req.HTTPMethod,
+		"allowed", d.Allowed,
+		"rule", d.Rule,
+	)
+	if d.Allowed {
+		log.Debug("acl decision")
+		return
+	}
+	log.Info("acl decision", "missing", d.Missing, "contacts", d.Contacts)
+}
+
+func (sc *SessionCheck) shadowHandler() func(events.Request, session.Session) (bool, error) {
+	if sc.ShadowACLHandler != nil {
+		return sc.ShadowACLHandler
+	}
+	if sc.ShadowRules != nil {
+		return RuleACL(sc.ShadowRules...)
+	}
+	return nil
+}
+
+func (sc *SessionCheck) checkShadow(req events.Request, sess session.Session, allowed bool) {
+	shadow := sc.shadowHandler()
+	if shadow == nil {
+		return
+	}
+	log := sc.logger().With("login", sess.Login, "path", req.Path, "method", req.HTTPMethod)
+
+	shadowAllowed, err := shadow(req, sess)
+	if err != nil {
+		log.Warn("shadow acl failed", "error", err)
+		sc.Metrics.Put("ACLShadowError", 1, metrics.Count)
+		return
+	}
+	if shadowAllowed == allowed {
+		sc.Metrics.Put("ACLShadowDisagreement", 0, metrics.Count)
+		return
+	}
+	log.Warn("shadow acl disagreement", "enforced", allowed, "shadow", shadowAllowed)
+	sc.Metrics.Put("ACLShadowDisagreement", 1, metrics.Count)
+}
+
+func (sc *SessionCheck) stale(sess session.Session) bool {
+	if sc.MaxAge <= 0 {
+		return false
+	}
+	validated := time.Unix(sess.ValidatedAt, 0)
+	return time.Since(validated) > time.Duration(sc.MaxAge)*time.Second
 }
 
 // AuthFunc checks for valid auth using GitHub OAuth
@@ -22,7 +155,7 @@ func (sc *SessionCheck) AuthFunc(req events.Request) (events.Response, error) {
 		return events.Fail("failed to authenticate request")
 	}
 
-	if sess.Login == "" {
+	if sess.Login == "" || sc.stale(sess) {
 		authURL, err := url.Parse(sc.AuthURL)
 		if err != nil {
 			return events.Response{}, err
@@ -35,17 +168,46 @@ func (sc *SessionCheck) AuthFunc(req events.Request) (events.Response, error) {
 		}
 		returnValues := authURL.Query()
 		returnValues.Set("redirect", returnURL.String())
+		if sess.Login != "" {
+			returnValues.Set("revalidate", "1")
+		}
 		authURL.RawQuery = returnValues.Encode()
 
 		return events.Redirect(authURL.String(), 303)
 	}
 
-	allowed, err := sc.ACLHandler(req, sess)
+	err = sc.resolveRepos(&sess)
+	if err != nil {
+		return events.Fail("failed to authenticate request")
+	}
+
+	d, err := sc.decisionHandler()(req, sess)
 	if err != nil {
+		sc.logger().Error("acl evaluation failed", "login", sess.Login, "path", req.Path, "error", err)
 		return events.Fail("failed to authenticate request")
 	}
-	if allowed {
+	sc.logDecision(req, sess, d)
+	sc.checkShadow(req, sess, d.Allowed)
+	if d.Allowed {
 		return events.Response{}, nil
 	}
-	return events.Reject("Not authorized")
+
+	event := audit.NewEvent(audit.ACLDeny, req, sess)
+	event.Reason = strings.Join(d.Missing, "; ")
+	audit.Emit(sc.AuditSink, event)
+
+	if len(d.Contacts) == 0 && sc.Contact != "" {
+		d.Contacts = []string{sc.Contact}
+	}
+	if sc.RequestAccessURL != "" {
+		d.RequestURL, err = sc.requestURL(req, sess, d)
+		if err != nil {
+			return events.Response{}, err
+		}
+	}
+	deniedPage := sc.DeniedPage
+	if deniedPage == nil {
+		deniedPage = DeniedPage
+	}
+	return deniedPage(req, sess, d)
 }
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/akerl/github-auth-lambda/metrics"
+	"github.com/akerl/go-lambda/apigw/events"
+)
+
+func TestCheckShadow(t *testing.T) {
+	cases := []struct {
+		name    string
+		rules   []Rule
+		allowed bool
+		metric  string
+		value   float64
+		logged  string
+	}{
+		{"no shadow policy", nil, true, "", 0, ""},
+		{"agrees", []Rule{{Teams: []string{"org/sre"}}}, true, "ACLShadowDisagreement", 0, ""},
+		{"disagrees", []Rule{{Teams: []string{"org/ops"}}}, true, "ACLShadowDisagreement", 1, "shadow acl disagreement"},
+		{"fails", []Rule{{Expression: `request.headers["missing"] == "x"`}}, false, "ACLShadowError", 1, "shadow acl failed"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var logs, emf bytes.Buffer
+			recorder := metrics.New("test", nil)
+			recorder.Writer = &emf
+			sc := SessionCheck{
+				ShadowRules: c.rules,
+				Logger:      slog.New(slog.NewTextHandler(&logs, nil)),
+				Metrics:     recorder,
+			}
+
+			sc.checkShadow(events.Request{Path: "/"}, testSession(), c.allowed)
+			if err := recorder.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			if c.metric == "" {
+				if emf.Len() > 0 || logs.Len() > 0 {
+					t.Errorf("expected no output, got metrics %q and logs %q", emf.String(), logs.String())
+				}
+				return
+			}
+			var record map[string]interface{}
+			if err := json.Unmarshal(emf.Bytes(), &record); err != nil {
+				t.Fatalf("invalid EMF record %q: %s", emf.String(), err)
+			}
+			if got, ok := record[c.metric].(float64); !ok || got != c.value {
+				t.Errorf("%s = %v, want %v", c.metric, record[c.metric], c.value)
+			}
+			if c.logged == "" && logs.Len() > 0 {
+				t.Errorf("unexpected logs: %s", logs.String())
+			}
+			if c.logged != "" && !strings.Contains(logs.String(), c.logged) {
+				t.Errorf("expected %q to be logged, got %s", c.logged, logs.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/akerl/github-auth-lambda/session"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// Defaults for on-demand repo permission lookups
+const (
+	DefaultRepoCacheTTL = 5 * time.Minute
+	DefaultRepoTimeout  = 5 * time.Second
+)
+
+// RepoRequirement requires at least a given permission on a repo
+type RepoRequirement struct {
+	Repo       string `json:"repo"`
+	Permission string `json:"permission"`
+}
+
+type repoPermission struct {
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name"`
+}
+
+// GetRepoPermission looks up a user's permission on a repo, named as
+// "owner/repo", via the collaborators permission API. Repos that aren't found
+// are reported as "none"; other errors, including rate limits and SSO
+// enforcement, are returned rather than treated as a lack of access.
+func GetRepoPermission(ctx context.Context, client *github.Client, repo, login string) (string, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repo name: %s", repo)
+	}
+	u := fmt.Sprintf("repos/%s/%s/collaborators/%s/permission", owner, name, login)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var rp repoPermission
+	_, err = client.Do(ctx, req, &rp)
+	if isRepoNotFound(err) {
+		return "none", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	// role_name distinguishes triage and maintain, which permission folds
+	// into read and write
+	if session.RepoPermissionRank(rp.RoleName) > 0 {
+		return rp.RoleName, nil
+	}
+	return rp.Permission, nil
+}
+
+// GetOwnRepoPermission looks up the permission of the client's own user on a
+// repo, named as "owner/repo". Unlike the collaborators API, this works for
+// users with less than write access. The client's token needs the repo scope
+// to see private repos, which are otherwise reported as "none".
+func GetOwnRepoPermission(ctx context.Context, client *github.Client, repo string) (string, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repo name: %s", repo)
+	}
+	r, _, err := client.Repositories.Get(ctx, owner, name)
+	if isRepoNotFound(err) {
+		return "none", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	permissions := r.GetPermissions()
+	for idx := len(session.RepoPermissionLevels) - 1; idx > 0; idx-- {
+		level := session.RepoPermissionLevels[idx]
+		if permissions[repoPermissionKeys[level]] {
+			return level, nil
+		}
+	}
+	return "none", nil
+}
+
+// repoPermissionKeys maps permission levels to the keys used for them in a
+// repo's permissions
+var repoPermissionKeys = map[string]string{
+	"read":     "pull",
+	"triage":   "triage",
+	"write":    "push",
+	"maintain": "maintain",
+	"admin":    "admin",
+}
+
+func isRepoNotFound(err error) bool {
+	var errResp *github.ErrorResponse
+	return errors.As(err, &errResp) && errResp.Response != nil &&
+		errResp.Response.StatusCode == http.StatusNotFound
+}
+
+// ClientRepoPermission returns a RepoPermissionFunc which resolves repo
+// permissions using the app's own client, such as one authenticated as a
+// GitHub App installation. The user's token isn't available to apps using
+// SessionCheck.
+func ClientRepoPermission(client *github.Client) func(context.Context, session.Session, string) (string, error) {
+	return func(ctx context.Context, sess session.Session, repo string) (string, error) {
+		return GetRepoPermission(ctx, client, repo, sess.Login)
+	}
+}
+
+type repoCacheEntry struct {
+	permission string
+	fetched    time.Time
+}
+
+type repoCache struct {
+	entries map[string]repoCacheEntry
+	mutex   sync.Mutex
+}
+
+func (c *repoCache) get(key string, ttl time.Duration) (string, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Since(entry.fetched) > ttl {
+		return "", false
+	}
+	return entry.permission, true
+}
+
+func (c *repoCache) set(key, permission string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.entries == nil {
+		c.entries = map[string]repoCacheEntry{}
+	}
+	c.entries[key] = repoCacheEntry{permission: permission, fetched: time.Now()}
+}
+
+// resolveRepos fills in permissions for repos referenced by the rules and
+// shadow rules that weren't resolved at login, using RepoPermissionFunc
+func (sc *SessionCheck) resolveRepos(sess *session.Session) error {
+	if sc.RepoPermissionFunc == nil {
+		return nil
+	}
+	ttl := sc.RepoCacheTTL
+	if ttl == 0 {
+		ttl = DefaultRepoCacheTTL
+	}
+	timeout := sc.RepoTimeout
+	if timeout == 0 {
+		timeout = DefaultRepoTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	rules := append([]Rule{}, sc.Rules...)
+	rules = append(rules, sc.ShadowRules...)
+	for _, r := range rules {
+		for _, req := range r.Repos {
+			repo := strings.ToLower(req.Repo)
+			if _, ok := sess.RepoPermissions[repo]; ok {
+				continue
+			}
+			key := sess.Login + " " + repo
+			permission, ok := sc.repoCache.get(key, ttl)
+			if !ok {
+				var err error
+				permission, err = sc.RepoPermissionFunc(ctx, *sess, repo)
+				if err != nil {
+					return err
+				}
+				sc.repoCache.set(key, permission)
+			}
+			if sess.RepoPermissions == nil {
+				sess.RepoPermissions = map[string]string{}
+			}
+			sess.RepoPermissions[repo] = permission
+		}
+	}
+	return nil
+}
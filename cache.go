@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/akerl/github-auth-lambda/metrics"
+	"github.com/akerl/go-lambda/s3"
+)
+
+type cacheKeyType struct{}
+
+const (
+	// memoryCacheSize bounds the entries kept in memory by a warm container
+	memoryCacheSize = 2000
+	// cacheEntryTTL is how long an entry is trusted before it's refetched
+	cacheEntryTTL = 7 * 24 * time.Hour
+)
+
+var (
+	cacheKey      = cacheKeyType{}
+	identityCache cacheStore
+)
+
+// withCache marks outbound requests on the context as cacheable, keyed on a
+// hash of the request's token
+func withCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheKey, int64(0))
+}
+
+// withUserCache keys cacheable requests on the user's ID instead, so entries
+// are reused across their logins rather than for a single token. GitHub still
+// validates the ETag against the request's own token.
+func withUserCache(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, cacheKey, userID)
+}
+
+type cacheEntry struct {
+	ETag   string      `json:"etag"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+	Stored time.Time   `json:"stored"`
+}
+
+func (e cacheEntry) expired() bool {
+	return time.Since(e.Stored) > cacheEntryTTL
+}
+
+// cacheStore persists conditional request responses between invocations
+type cacheStore interface {
+	Get(key string) (cacheEntry, bool)
+	Set(key string, entry cacheEntry) error
+}
+
+type memoryStore struct {
+	entries map[string]cacheEntry
+	mutex   sync.RWMutex
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{entries: map[string]cacheEntry{}}
+}
+
+func (m *memoryStore) Get(key string) (cacheEntry, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	entry, ok := m.entries[key]
+	if !ok || entry.expired() {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+// Set stores an entry, evicting the oldest entry once the store is full
+func (m *memoryStore) Set(key string, entry cacheEntry) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, ok := m.entries[key]; !ok && len(m.entries) >= memoryCacheSize {
+		var oldest string
+		for k, e := range m.entries {
+			if oldest == "" || e.Stored.Before(m.entries[oldest].Stored) {
+				oldest = k
+			}
+		}
+		delete(m.entries, oldest)
+	}
+	m.entries[key] = entry
+	return nil
+}
+
+// s3Store keeps entries in S3, fronted by the in-memory store for warm
+// containers. Entries hold users' memberships, so they're encrypted with a
+// key derived from the ServerKey. Entries older than cacheEntryTTL are
+// ignored and overwritten on the next lookup; objects for users who stop
+// logging in stay behind, so the prefix should have a lifecycle rule expiring
+// objects after the same period.
+type s3Store struct {
+	Bucket string
+	Prefix string
+	memory *memoryStore
+}
+
+func newS3Store(bucket, prefix string) *s3Store {
+	return &s3Store{Bucket: bucket, Prefix: prefix, memory: newMemoryStore()}
+}
+
+func (s *s3Store) objectKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return s.Prefix + hex.EncodeToString(sum[:])
+}
+
+func (s *s3Store) Get(key string) (cacheEntry, bool) {
+	if entry, ok := s.memory.Get(key); ok {
+		return entry, true
+	}
+	obj, err := s3.GetObject(s.Bucket, s.objectKey(key))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+	data, err := unseal("cache", obj)
+	if err != nil {
+		return cacheEntry{}, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.expired() {
+		return cacheEntry{}, false
+	}
+	_ = s.memory.Set(key, entry)
+	return entry, true
+}
+
+func (s *s3Store) Set(key string, entry cacheEntry) error {
+	_ = s.memory.Set(key, entry)
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	sealed, err := seal("cache", data)
+	if err != nil {
+		return err
+	}
+	return s3.PutObject(s.Bucket, s.objectKey(key), string(sealed))
+}
+
+// cacheTransport sends If-None-Match for previously seen GitHub responses and
+// serves the cached body on a 304, which doesn't count against rate limits
+type cacheTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	userID, cacheable := req.Context().Value(cacheKey).(int64)
+	auth := req.Header.Get("Authorization")
+	if identityCache == nil || !cacheable || auth == "" || req.Method != http.MethodGet {
+		return t.Base.RoundTrip(req)
+	}
+
+	var key string
+	if userID != 0 {
+		key = fmt.Sprintf("user/%d %s", userID, req.URL.String())
+	} else {
+		sum := sha256.Sum256([]byte(auth))
+		key = "token/" + hex.EncodeToString(sum[:]) + " " + req.URL.String()
+	}
+	entry, cached := identityCache.Get(key)
+	if cached {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if cached && resp.StatusCode == http.StatusNotModified {
+		requestMetrics.Put("GitHubCacheHit", 1, metrics.Count)
+		resp.Body.Close()
+		header := entry.Header.Clone()
+		for k, v := range resp.Header {
+			header[k] = v
+		}
+		resp.StatusCode = http.StatusOK
+		resp.Status = http.StatusText(http.StatusOK)
+		resp.Header = header
+		resp.Body = io.NopCloser(bytes.NewReader(entry.Body))
+		resp.ContentLength = int64(len(entry.Body))
+		return resp, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	err = identityCache.Set(key, cacheEntry{
+		ETag:   etag,
+		Header: resp.Header.Clone(),
+		Body:   body,
+		Stored: time.Now(),
+	})
+	if err != nil {
+		requestLog.Warn("failed to cache github response", "error", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	responseMargin = 500 * time.Millisecond
+	retryBase      = 250 * time.Millisecond
+	retryMax       = 10 * time.Second
+)
+
+var (
+	invocationCtx = context.Background()
+	httpClient    = &http.Client{
+		Transport: &ssoTransport{
+			Base: &cacheTransport{
+				Base: &retryTransport{
+					Base: otelhttp.NewTransport(http.DefaultTransport),
+				},
+			},
+		},
+	}
+)
+
+// requestContext derives a context that expires shortly before the Lambda
+// invocation does, leaving time to return a response
+func requestContext() (context.Context, context.CancelFunc) {
+	deadline, ok := invocationCtx.Deadline()
+	if !ok {
+		return context.WithCancel(invocationCtx)
+	}
+	return context.WithDeadline(invocationCtx, deadline.Add(-responseMargin))
+}
+
+type callLimitKey struct{}
+
+// withCallLimit bounds how many calls started from ctx run at once, however
+// deeply the lookups making them are nested
+func withCallLimit(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, callLimitKey{}, make(chan struct{}, limit))
+}
+
+// acquireCall waits for a slot under ctx's call limit, returning the func
+// that releases it
+func acquireCall(ctx context.Context) func() {
+	sem, ok := ctx.Value(callLimitKey{}).(chan struct{})
+	if !ok {
+		return func() {}
+	}
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }
+	case <-ctx.Done():
+		// The call fails on the cancelled context without needing a slot
+		return func() {}
+	}
+}
+
+// startCall wraps an outbound call with a timeout and a trace span, waiting
+// for a slot first if ctx has a call limit
+func startCall(ctx context.Context, name string) (context.Context, func(error)) {
+	release := acquireCall(ctx)
+	timeout := time.Duration(config.GitHubTimeout) * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	ctx, span := tracer.Start(ctx, name)
+	return ctx, func(err error) {
+		endSpan(span, err)
+		cancel()
+		release()
+	}
+}
+
+type retryTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		return t.Base.RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.Base.RoundTrip(req)
+		if attempt >= config.GitHubRetries || !shouldRetry(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		wait := retryDelay(resp, attempt)
+		if deadline, ok := req.Context().Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		requestLog.Warn("retrying github request", "url", req.URL.Path, "attempt", attempt, "wait", wait)
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled)
+	}
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		// Secondary rate limits are signalled with a 403 and Retry-After
+		return resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if after := resp.Header.Get("Retry-After"); after != "" {
+			if seconds, err := strconv.Atoi(after); err == nil {
+				return time.Duration(seconds) * time.Second
+			}
+			if date, err := http.ParseTime(after); err == nil {
+				return time.Until(date)
+			}
+		}
+	}
+	backoff := retryBase << (attempt - 1)
+	if backoff > retryMax {
+		backoff = retryMax
+	}
+	// #nosec G404 -- jitter does not need a secure source
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	response := func(code int, header http.Header) *http.Response {
+		return &http.Response{StatusCode: code, Header: header}
+	}
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		resp *http.Response
+		err  error
+		want bool
+	}{
+		{"network error", context.Background(), nil, errors.New("connection reset"), true},
+		{"cancelled context", cancelled, nil, errors.New("connection reset"), false},
+		{"cancelled error", context.Background(), nil, context.Canceled, false},
+		{"ok", context.Background(), response(200, http.Header{}), nil, false},
+		{"not found", context.Background(), response(404, http.Header{}), nil, false},
+		{"server error", context.Background(), response(502, http.Header{}), nil, true},
+		{"rate limited", context.Background(), response(429, http.Header{}), nil, true},
+		{"secondary rate limit", context.Background(), response(403, http.Header{"Retry-After": {"5"}}), nil, true},
+		{"forbidden", context.Background(), response(403, http.Header{}), nil, false},
+	}
+	for _, c := range cases {
+		if got := shouldRetry(c.ctx, c.resp, c.err); got != c.want {
+			t.Errorf("%s: shouldRetry = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	retryAfter := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": {value}}}
+	}
+
+	if got := retryDelay(retryAfter("7"), 1); got != 7*time.Second {
+		t.Errorf("Retry-After seconds: got %s", got)
+	}
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	if got := retryDelay(retryAfter(date), 1); got < 28*time.Second || got > 30*time.Second {
+		t.Errorf("Retry-After date: got %s", got)
+	}
+
+	cases := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{1, retryBase},
+		{2, 2 * retryBase},
+		{3, 4 * retryBase},
+		{20, retryMax},
+	}
+	for _, c := range cases {
+		for i := 0; i < 20; i++ {
+			got := retryDelay(retryAfter("soon"), c.attempt)
+			if got < c.max/2 || got > c.max {
+				t.Errorf("attempt %d: got %s, want between %s and %s", c.attempt, got, c.max/2, c.max)
+			}
+		}
+	}
+}
@@ -3,10 +3,15 @@ package main
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 
+	"github.com/akerl/github-auth-lambda/auth"
 	"github.com/akerl/go-lambda/s3"
 )
 
+// roleMapping maps application namespaces to role names to the rule granting them
+type roleMapping map[string]map[string]auth.Rule
+
 type configFile struct {
 	ClientSecret  string            `json:"clientsecret"`
 	ClientID      string            `json:"clientid"`
@@ -16,7 +21,41 @@ type configFile struct {
 	Base64EncKey  string            `json:"enckey"`
 	SignKey       []byte            `json:"-"`
 	EncKey        []byte            `json:"-"`
+	Base64SrvKey  string            `json:"serverkey"`
+	ServerKey     []byte            `json:"-"`
 	TemplateData  map[string]string `json:"templatedata"`
+	Scopes        []string          `json:"scopes"`
+	GitHubApp     bool              `json:"githubapp"`
+	Revalidate    int               `json:"revalidate"`
+	AppID         int64             `json:"appid"`
+	AppPrivateKey string            `json:"appprivatekey"`
+	AppOrgs       []string          `json:"apporgs"`
+	AppMembers    bool              `json:"appmemberships"`
+	Profile       bool              `json:"profile"`
+	Email         bool              `json:"email"`
+	EmailDomains  []string          `json:"emaildomains"`
+	AuditBucket   string            `json:"auditbucket"`
+	AuditPrefix   string            `json:"auditprefix"`
+	AuditBatch    int               `json:"auditbatch"`
+	LogLevel      string            `json:"loglevel"`
+	MetricsNS     string            `json:"metricsnamespace"`
+	TraceExporter string            `json:"traceexporter"`
+	TraceEndpoint string            `json:"traceendpoint"`
+	GitHubTimeout int               `json:"githubtimeout"`
+	GitHubRetries int               `json:"githubretries"`
+	GitHubWorkers int               `json:"githubparallelism"`
+	CacheBucket   string            `json:"cachebucket"`
+	CachePrefix   string            `json:"cacheprefix"`
+	Organizations []orgFilter       `json:"organizations"`
+	RequireMember []string          `json:"requiremembership"`
+	Repositories  []string          `json:"repositories"`
+	Roles         roleMapping       `json:"roles"`
+	TeamRoles     bool              `json:"teamroles"`
+	Require2FA    bool              `json:"require2fa"`
+	Require2FAOrg []string          `json:"require2faorgs"`
+	AccessWebhook string            `json:"accesswebhook"`
+	AccessRepo    string            `json:"accessrepo"`
+	Approvers     auth.Rule         `json:"accessapprovers"`
 }
 
 func loadConfig() (*configFile, error) {
@@ -26,14 +65,103 @@ func loadConfig() (*configFile, error) {
 		return &c, err
 	}
 	cf.OnError = func(_ *s3.ConfigFile, err error) {
-		fmt.Println(err)
+		logger.Error("failed to reload config", "error", err)
+	}
+	cf.OnSuccess = func(_ *s3.ConfigFile) {
+		err := setLogLevel(c.LogLevel)
+		if err != nil {
+			logger.Error("invalid log level in config", "error", err)
+		}
 	}
 	cf.Autoreload(60)
 
+	err = setLogLevel(c.LogLevel)
+	if err != nil {
+		return &c, err
+	}
+
 	if c.Lifetime == 0 {
 		c.Lifetime = 86400
 	}
 
+	if c.GitHubApp || c.AppMembers {
+		// Memberships come from the app's permissions, so the user's token
+		// only needs to identify them
+		c.Scopes = nil
+	} else if len(c.Scopes) == 0 {
+		c.Scopes = defaultScopes
+	}
+	if !c.GitHubApp && c.needsEmails() && !hasScope(c.Scopes, "user:email") && !hasScope(c.Scopes, "user") {
+		c.Scopes = append(c.Scopes, "user:email")
+	}
+	if !c.GitHubApp && c.Require2FA && !hasScope(c.Scopes, "read:user") && !hasScope(c.Scopes, "user") {
+		// two_factor_authentication is only returned with read:user
+		c.Scopes = append(c.Scopes, "read:user")
+	}
+
+	if c.GitHubTimeout == 0 {
+		c.GitHubTimeout = 5
+	}
+
+	if c.GitHubRetries == 0 {
+		c.GitHubRetries = 3
+	}
+
+	if c.GitHubWorkers == 0 {
+		c.GitHubWorkers = 4
+	}
+
+	if c.MetricsNS == "" {
+		c.MetricsNS = "github-auth-lambda"
+	}
+
+	if c.AuditBatch == 0 {
+		c.AuditBatch = 25
+	}
+
+	for _, f := range c.Organizations {
+		if err := f.validate(); err != nil {
+			return &c, err
+		}
+	}
+
+	if !c.GitHubApp && !c.AppMembers && len(c.Repositories) > 0 && !hasScope(c.Scopes, "repo") {
+		// Private repos are hidden from tokens without the repo scope, which
+		// would otherwise look the same as having no access
+		return &c, fmt.Errorf("repositories needs appmemberships or the repo scope listed in scopes")
+	}
+
+	if c.AccessRepo != "" && !strings.Contains(c.AccessRepo, "/") {
+		return &c, fmt.Errorf("accessrepo must be in owner/repo form: %s", c.AccessRepo)
+	}
+
+	if c.accessEnabled() {
+		if c.Approvers.Empty() {
+			return &c, fmt.Errorf("accessapprovers must be set to handle access requests")
+		}
+		if err := auth.CompileRules([]auth.Rule{c.Approvers}); err != nil {
+			return &c, fmt.Errorf("invalid accessapprovers: %s", err)
+		}
+	}
+
+	if c.AppMembers || c.AccessRepo != "" {
+		if c.AppID == 0 || c.AppPrivateKey == "" {
+			return &c, fmt.Errorf("appid and appprivatekey must be set to resolve memberships or open issues with the app")
+		}
+		appKey, err = parseAppKey(c.AppPrivateKey)
+		if err != nil {
+			return &c, err
+		}
+	}
+
+	for app, roles := range c.Roles {
+		for name, rule := range roles {
+			if err := auth.CompileRoleRules([]auth.Rule{rule}); err != nil {
+				return &c, fmt.Errorf("invalid role %s/%s: %s", app, name, err)
+			}
+		}
+	}
+
 	if c.ClientSecret == "" || c.ClientID == "" {
 		return &c, fmt.Errorf("clientid and clientsecret not set")
 	}
@@ -51,5 +179,36 @@ func loadConfig() (*configFile, error) {
 		return &c, err
 	}
 
+	if c.Base64SrvKey == "" && c.needsServerKey() {
+		return &c, fmt.Errorf("serverkey must be set to use cachebucket, revalidate, githubapp or access requests")
+	}
+	c.ServerKey, err = base64.URLEncoding.DecodeString(c.Base64SrvKey)
+	if err != nil {
+		return &c, err
+	}
+
 	return &c, nil
 }
+
+// needsServerKey checks if any enabled feature keeps secrets that the apps
+// sharing the cookie keys mustn't be able to read
+func (c *configFile) needsServerKey() bool {
+	return c.CacheBucket != "" || c.GitHubApp || c.Revalidate > 0 || c.accessEnabled()
+}
+
+func (c *configFile) accessEnabled() bool {
+	return c.AccessWebhook != "" || c.AccessRepo != ""
+}
+
+func (c *configFile) needsEmails() bool {
+	return c.Email || len(c.EmailDomains) > 0
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
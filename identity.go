@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/akerl/github-auth-lambda/metrics"
+	"github.com/akerl/github-auth-lambda/session"
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	teamsPerPage = 100
+	orgsPerPage  = 100
+)
+
+type identity struct {
+	User           *github.User
+	Teams          []*github.Team
+	InheritedTeams []*github.Team
+	TeamRoles      map[int64]string
+	Orgs           []*github.Membership
+	Emails         []*github.UserEmail
+	Repos          map[string]string
+	SSOOrgs        map[string]string
+}
+
+// collectIdentity looks up the user, then runs the remaining GitHub lookups
+// concurrently, cancelling the rest if any of them fail. The user comes first
+// so the other lookups can be cached against their ID, which outlives the
+// token used for this login. All of the lookups share one call limit.
+func collectIdentity(ctx context.Context, client *github.Client) (identity, error) {
+	var id identity
+
+	tracker := newSSOTracker()
+	lookupCtx := withSSOTracker(withCallLimit(ctx, config.GitHubWorkers), tracker)
+
+	start := time.Now()
+	callCtx, done := startCall(withCache(lookupCtx), "github.users.get")
+	user, resp, err := client.Users.Get(callCtx, "")
+	done(err)
+	requestMetrics.PutDuration("UserLookupLatency", time.Since(start))
+	if err != nil {
+		return id, fmt.Errorf("error getting name: %s", err)
+	}
+	recordRate(resp)
+	id.User = user
+
+	g, ctx := errgroup.WithContext(withUserCache(lookupCtx, user.GetID()))
+
+	if config.AppMembers {
+		g.Go(func() error {
+			start := time.Now()
+			err := collectAppMemberships(ctx, user.GetLogin(), &id)
+			requestMetrics.PutDuration("AppMembershipsLookupLatency", time.Since(start))
+			return err
+		})
+	} else {
+		g.Go(func() error {
+			start := time.Now()
+			teams, err := listUserTeams(ctx, client)
+			requestMetrics.PutDuration("TeamsLookupLatency", time.Since(start))
+			if err != nil {
+				return fmt.Errorf("error getting teams: %s", err)
+			}
+			id.Teams = teams
+
+			tg, tctx := errgroup.WithContext(ctx)
+			tg.Go(func() error {
+				start := time.Now()
+				inherited, err := resolveParents(tctx, client, teams)
+				requestMetrics.PutDuration("ParentTeamsLookupLatency", time.Since(start))
+				if err != nil {
+					return fmt.Errorf("error getting parent teams: %s", err)
+				}
+				id.InheritedTeams = inherited
+				return nil
+			})
+			if config.TeamRoles {
+				tg.Go(func() error {
+					start := time.Now()
+					roles, err := fetchTeamRoles(tctx, client, user.GetLogin(), filterTeams(teams))
+					requestMetrics.PutDuration("TeamRolesLookupLatency", time.Since(start))
+					if err != nil {
+						return fmt.Errorf("error getting team roles: %s", err)
+					}
+					id.TeamRoles = roles
+					return nil
+				})
+			}
+			return tg.Wait()
+		})
+
+		g.Go(func() error {
+			start := time.Now()
+			orgs, err := listOrgMemberships(ctx, client)
+			requestMetrics.PutDuration("OrgsLookupLatency", time.Since(start))
+			if err != nil {
+				return fmt.Errorf("error getting orgs: %s", err)
+			}
+			id.Orgs = orgs
+			return nil
+		})
+	}
+
+	if config.needsEmails() {
+		g.Go(func() error {
+			start := time.Now()
+			callCtx, done := startCall(ctx, "github.users.emails")
+			emails, resp, err := client.Users.ListEmails(callCtx, &github.ListOptions{PerPage: 100})
+			done(err)
+			requestMetrics.PutDuration("EmailsLookupLatency", time.Since(start))
+			if err != nil {
+				return fmt.Errorf("error getting emails: %s", err)
+			}
+			recordRate(resp)
+			id.Emails = emails
+			return nil
+		})
+	}
+
+	if len(config.Repositories) > 0 {
+		g.Go(func() error {
+			start := time.Now()
+			repos, err := resolveRepoPermissions(ctx, client, user.GetLogin())
+			requestMetrics.PutDuration("ReposLookupLatency", time.Since(start))
+			if err != nil {
+				return fmt.Errorf("error getting repo permissions: %s", err)
+			}
+			id.Repos = repos
+			return nil
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return id, err
+	}
+
+	id.SSOOrgs, err = tracker.resolve(withUserCache(lookupCtx, user.GetID()), client)
+	if err != nil {
+		return id, fmt.Errorf("error resolving sso orgs: %s", err)
+	}
+	return id, nil
+}
+
+// listUserTeams fetches the first page of teams, then any remaining pages in parallel
+func listUserTeams(ctx context.Context, client *github.Client) ([]*github.Team, error) {
+	callCtx, done := startCall(ctx, "github.teams.list")
+	first, resp, err := client.Teams.ListUserTeams(callCtx, &github.ListOptions{PerPage: teamsPerPage})
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	recordRate(resp)
+	if resp.LastPage <= 1 {
+		return first, nil
+	}
+
+	pages := make([][]*github.Team, resp.LastPage)
+	pages[0] = first
+	g, ctx := errgroup.WithContext(ctx)
+	for page := 2; page <= resp.LastPage; page++ {
+		page := page
+		g.Go(func() error {
+			callCtx, done := startCall(ctx, "github.teams.list")
+			teams, resp, err := client.Teams.ListUserTeams(
+				callCtx,
+				&github.ListOptions{Page: page, PerPage: teamsPerPage},
+			)
+			done(err)
+			if err != nil {
+				return err
+			}
+			recordRate(resp)
+			pages[page-1] = teams
+			return nil
+		})
+	}
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*github.Team
+	for _, page := range pages {
+		teams = append(teams, page...)
+	}
+	return teams, nil
+}
+
+// listOrgMemberships fetches the first page of active org memberships, then
+// any remaining pages in parallel
+func listOrgMemberships(ctx context.Context, client *github.Client) ([]*github.Membership, error) {
+	opts := func(page int) *github.ListOrgMembershipsOptions {
+		return &github.ListOrgMembershipsOptions{
+			State:       "active",
+			ListOptions: github.ListOptions{Page: page, PerPage: orgsPerPage},
+		}
+	}
+	callCtx, done := startCall(ctx, "github.orgs.memberships")
+	first, resp, err := client.Organizations.ListOrgMemberships(callCtx, opts(1))
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	recordRate(resp)
+	if resp.LastPage <= 1 {
+		return first, nil
+	}
+
+	pages := make([][]*github.Membership, resp.LastPage)
+	pages[0] = first
+	g, ctx := errgroup.WithContext(ctx)
+	for page := 2; page <= resp.LastPage; page++ {
+		page := page
+		g.Go(func() error {
+			callCtx, done := startCall(ctx, "github.orgs.memberships")
+			orgs, resp, err := client.Organizations.ListOrgMemberships(callCtx, opts(page))
+			done(err)
+			if err != nil {
+				return err
+			}
+			recordRate(resp)
+			pages[page-1] = orgs
+			return nil
+		})
+	}
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	var orgs []*github.Membership
+	for _, page := range pages {
+		orgs = append(orgs, page...)
+	}
+	return orgs, nil
+}
+
+func recordRate(resp *github.Response) {
+	if resp == nil {
+		return
+	}
+	requestMetrics.PutMin("GitHubRateLimitRemaining", float64(resp.Rate.Remaining), metrics.Count)
+}
+
+// apply stores the user's identity on the session, limited to the configured orgs
+func (id identity) apply(sess *session.Session) {
+	sess.Login = id.User.GetLogin()
+	sess.UserID = id.User.GetID()
+	if config.Profile {
+		sess.Name = id.User.GetName()
+		sess.AvatarURL = id.User.GetAvatarURL()
+	}
+	if config.Email {
+		sess.Email = id.primaryEmail()
+	}
+	sess.DomainEmail = id.domainEmail()
+	sess.SSORequired = pendingSSO(id.SSOOrgs)
+	sess.RepoPermissions = id.Repos
+	teams := filterTeams(id.Teams)
+	inherited := filterTeams(id.InheritedTeams)
+	sess.Memberships = teamMap(teams)
+	sess.InheritedMemberships = teamMap(inherited)
+
+	sess.Orgs = map[string]session.OrgMembership{}
+	org := func(o *github.Organization) session.OrgMembership {
+		om, ok := sess.Orgs[o.GetLogin()]
+		if !ok {
+			om = session.OrgMembership{ID: o.GetID(), Teams: map[string]session.TeamMembership{}}
+		}
+		return om
+	}
+	for _, m := range filterOrgs(id.Orgs) {
+		om := org(m.GetOrganization())
+		om.Role = m.GetRole()
+		sess.Orgs[m.GetOrganization().GetLogin()] = om
+	}
+	for _, t := range inherited {
+		om := org(t.GetOrganization())
+		om.Teams[t.GetSlug()] = session.TeamMembership{
+			ID:        t.GetID(),
+			Role:      session.RoleMember,
+			Inherited: true,
+		}
+		sess.Orgs[t.GetOrganization().GetLogin()] = om
+	}
+	for _, t := range teams {
+		om := org(t.GetOrganization())
+		om.Teams[t.GetSlug()] = session.TeamMembership{
+			ID:   t.GetID(),
+			Role: id.TeamRoles[t.GetID()],
+		}
+		sess.Orgs[t.GetOrganization().GetLogin()] = om
+	}
+}
+
+func (id identity) primaryEmail() string {
+	for _, e := range id.Emails {
+		if e.GetPrimary() && e.GetVerified() {
+			return e.GetEmail()
+		}
+	}
+	return ""
+}
+
+// domainEmail returns the first verified email in one of the configured domains,
+// preferring the primary email
+func (id identity) domainEmail() string {
+	if len(config.EmailDomains) == 0 {
+		return ""
+	}
+	primary := id.primaryEmail()
+	if emailInDomains(primary) {
+		return primary
+	}
+	for _, e := range id.Emails {
+		if e.GetVerified() && emailInDomains(e.GetEmail()) {
+			return e.GetEmail()
+		}
+	}
+	return ""
+}
+
+func teamMap(teams []*github.Team) map[string][]string {
+	m := make(map[string][]string)
+	for _, t := range teams {
+		org := t.GetOrganization().GetLogin()
+		m[org] = append(m[org], t.GetSlug())
+	}
+	return m
+}
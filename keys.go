@@ -0,0 +1,54 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+)
+
+// serverKey derives a key for one purpose from the ServerKey. Unlike the
+// cookie keys, which every app using SessionCheck holds, the ServerKey is only
+// known to the auth lambda.
+func serverKey(purpose string) []byte {
+	mac := hmac.New(sha256.New, config.ServerKey)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// seal encrypts data with AES-GCM using a key derived for the purpose
+func seal(purpose string, data []byte) ([]byte, error) {
+	gcm, err := serverCipher(purpose)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// unseal decrypts data encrypted by seal for the same purpose
+func unseal(purpose string, data []byte) ([]byte, error) {
+	gcm, err := serverCipher(purpose)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("sealed data is too short")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func serverCipher(purpose string) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(serverKey(purpose))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
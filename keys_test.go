@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSeal(t *testing.T) {
+	config = &configFile{ServerKey: []byte("server-key")}
+	data := []byte("gho_secret")
+
+	sealed, err := seal("token", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sealed, data) {
+		t.Fatalf("sealed data contains the plaintext")
+	}
+	again, err := seal("token", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(sealed, again) {
+		t.Errorf("sealing twice gave the same output")
+	}
+
+	opened, err := unseal("token", sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(opened, data) {
+		t.Errorf("unsealed %q, want %q", opened, data)
+	}
+
+	tampered := append([]byte{}, sealed...)
+	tampered[len(tampered)-1] ^= 1
+	cases := []struct {
+		name    string
+		purpose string
+		data    []byte
+	}{
+		{"other purpose", "cache", sealed},
+		{"tampered", "token", tampered},
+		{"truncated", "token", sealed[:4]},
+	}
+	for _, c := range cases {
+		if _, err := unseal(c.purpose, c.data); err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+
+	config = &configFile{ServerKey: []byte("other-key")}
+	if _, err := unseal("token", sealed); err == nil {
+		t.Errorf("different server key: expected an error")
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+var (
+	logLevel   = new(slog.LevelVar)
+	logger     = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel, ReplaceAttr: redactAttr}))
+	requestLog = logger
+
+	redactedKeys = map[string]bool{
+		"cookie":        true,
+		"set-cookie":    true,
+		"authorization": true,
+		"code":          true,
+		"state":         true,
+	}
+)
+
+const redacted = "[REDACTED]"
+
+func redactAttr(_ []string, a slog.Attr) slog.Attr {
+	if redactedKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, redacted)
+	}
+	return a
+}
+
+func redactMap(m map[string]string) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		if redactedKeys[strings.ToLower(k)] {
+			v = redacted
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func setLogLevel(level string) error {
+	if level == "" {
+		return nil
+	}
+	var l slog.Level
+	err := l.UnmarshalText([]byte(level))
+	if err != nil {
+		return err
+	}
+	logLevel.Set(l)
+	return nil
+}
+
+func outcome(code int) string {
+	switch {
+	case code >= 500:
+		return "error"
+	case code >= 400:
+		return "rejected"
+	case code >= 300:
+		return "redirect"
+	default:
+		return "success"
+	}
+}
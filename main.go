@@ -1,36 +1,53 @@
 package main
 
 import (
+	"context"
+	"log/slog"
 	"regexp"
+	"time"
 
+	"github.com/akerl/github-auth-lambda/audit"
 	"github.com/akerl/github-auth-lambda/session"
+	"github.com/akerl/go-lambda/apigw/events"
 	"github.com/akerl/go-lambda/mux"
+	"github.com/aws/aws-lambda-go/lambda"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/github"
 )
 
 var (
-	config   *configFile
-	sm       *session.Manager
-	oauthCfg *oauth2.Config
-	scopes   = []string{"read:org"}
+	config    *configFile
+	sm        *session.Manager
+	oauthCfg  *oauth2.Config
+	auditSink audit.Sink
+
+	defaultScopes = []string{"read:org"}
 
 	authRegex     = regexp.MustCompile(`^/auth$`)
 	logoutRegex   = regexp.MustCompile(`^/logout$`)
 	callbackRegex = regexp.MustCompile(`^/callback$`)
 	indexRegex    = regexp.MustCompile(`^/$`)
 	faviconRegex  = regexp.MustCompile(`^/favicon.ico$`)
+	accessRegex   = regexp.MustCompile(`^/access$`)
+	decideRegex   = regexp.MustCompile(`^/access/decide$`)
 	defaultRegex  = regexp.MustCompile(`^/.*$`)
 )
 
 func main() {
 	var err error
 
+	slog.SetDefault(logger)
+
 	config, err = loadConfig()
 	if err != nil {
 		panic(err)
 	}
 
+	err = initTracing()
+	if err != nil {
+		panic(err)
+	}
+
 	sm = &session.Manager{
 		Name:     "session",
 		SignKey:  config.SignKey,
@@ -43,16 +60,36 @@ func main() {
 		ClientID:     config.ClientID,
 		ClientSecret: config.ClientSecret,
 		Endpoint:     github.Endpoint,
-		Scopes:       scopes,
+		Scopes:       config.Scopes,
+	}
+
+	identityCache = newMemoryStore()
+	if config.CacheBucket != "" {
+		identityCache = newS3Store(config.CacheBucket, config.CachePrefix)
+	}
+
+	auditSink = &audit.StdoutSink{}
+	if config.AuditBucket != "" {
+		auditSink = &audit.S3Sink{
+			Bucket:    config.AuditBucket,
+			Prefix:    config.AuditPrefix,
+			BatchSize: config.AuditBatch,
+			MaxAge:    5 * time.Minute,
+		}
 	}
 
 	d := mux.NewDispatcher(
-		mux.NewRoute(authRegex, authHandler),
-		mux.NewRoute(logoutRegex, logoutHandler),
-		mux.NewRoute(callbackRegex, callbackHandler),
-		mux.NewRoute(indexRegex, indexHandler),
-		mux.NewRoute(faviconRegex, faviconHandler),
-		mux.NewRoute(defaultRegex, defaultHandler),
+		mux.NewRoute(authRegex, route("auth", authHandler)),
+		mux.NewRoute(logoutRegex, route("logout", logoutHandler)),
+		mux.NewRoute(callbackRegex, route("callback", callbackHandler)),
+		mux.NewRoute(indexRegex, route("index", indexHandler)),
+		mux.NewRoute(faviconRegex, route("favicon", faviconHandler)),
+		mux.NewRoute(accessRegex, route("access", accessHandler)),
+		mux.NewRoute(decideRegex, route("decide", decideHandler)),
+		mux.NewRoute(defaultRegex, route("default", defaultHandler)),
 	)
-	mux.Start(d)
+	lambda.Start(func(ctx context.Context, req events.Request) (events.Response, error) {
+		invocationCtx = ctx
+		return d.Handle(req)
+	})
 }
@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Units supported by CloudWatch that we emit
+const (
+	Count        = "Count"
+	Milliseconds = "Milliseconds"
+)
+
+type metric struct {
+	value float64
+	unit  string
+}
+
+// Recorder collects metrics and writes them in CloudWatch Embedded Metric Format
+type Recorder struct {
+	Namespace  string
+	Dimensions map[string]string
+	Writer     io.Writer
+	metrics    map[string]metric
+	mutex      sync.Mutex
+}
+
+// New returns a Recorder for the given namespace and dimensions
+func New(namespace string, dimensions map[string]string) *Recorder {
+	if dimensions == nil {
+		dimensions = map[string]string{}
+	}
+	return &Recorder{
+		Namespace:  namespace,
+		Dimensions: dimensions,
+		metrics:    map[string]metric{},
+	}
+}
+
+// Put records a metric value, replacing any previous value with the same name
+func (r *Recorder) Put(name string, value float64, unit string) {
+	if r == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.metrics == nil {
+		r.metrics = map[string]metric{}
+	}
+	r.metrics[name] = metric{value: value, unit: unit}
+}
+
+// PutMin records a metric value unless a lower value has already been recorded
+func (r *Recorder) PutMin(name string, value float64, unit string) {
+	if r == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.metrics == nil {
+		r.metrics = map[string]metric{}
+	}
+	if existing, ok := r.metrics[name]; ok && existing.value <= value {
+		return
+	}
+	r.metrics[name] = metric{value: value, unit: unit}
+}
+
+// PutDuration records a duration in milliseconds
+func (r *Recorder) PutDuration(name string, d time.Duration) {
+	r.Put(name, float64(d.Microseconds())/1000, Milliseconds)
+}
+
+// SetDimension adds or updates a dimension
+func (r *Recorder) SetDimension(name, value string) {
+	if r == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.Dimensions[name] = value
+}
+
+// Flush writes the recorded metrics as a single EMF record and resets them
+func (r *Recorder) Flush() error {
+	if r == nil {
+		return nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.metrics) == 0 {
+		return nil
+	}
+
+	dimNames := make([]string, 0, len(r.Dimensions))
+	for name := range r.Dimensions {
+		dimNames = append(dimNames, name)
+	}
+	sort.Strings(dimNames)
+
+	metricNames := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		metricNames = append(metricNames, name)
+	}
+	sort.Strings(metricNames)
+
+	definitions := make([]map[string]string, len(metricNames))
+	record := map[string]interface{}{}
+	for idx, name := range metricNames {
+		m := r.metrics[name]
+		definitions[idx] = map[string]string{"Name": name, "Unit": m.unit}
+		record[name] = m.value
+	}
+	for name, value := range r.Dimensions {
+		record[name] = value
+	}
+	record["_aws"] = map[string]interface{}{
+		"Timestamp": time.Now().UnixMilli(),
+		"CloudWatchMetrics": []map[string]interface{}{{
+			"Namespace":  r.Namespace,
+			"Dimensions": [][]string{dimNames},
+			"Metrics":    definitions,
+		}},
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	w := r.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	r.metrics = map[string]metric{}
+	return err
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-github/v25/github"
+)
+
+const (
+	twoFactorHelpURL = "https://github.com/settings/security"
+	emailHelpURL     = "https://github.com/settings/emails"
+)
+
+// denial explains why a login was refused
+type denial struct {
+	Reason   string
+	Message  string
+	HelpURL  string
+	HelpText string
+}
+
+// checkLoginPolicy returns the first policy that refuses the login, if any
+func checkLoginPolicy(id identity) (denial, bool) {
+	if !hasRequiredMembership(id) {
+		return denial{
+			Reason:  "missing_required_membership",
+			Message: "You aren't a member of any of the organizations or teams required to log in.",
+		}, true
+	}
+	// A missing 2FA status is treated the same as 2FA being disabled
+	if requiresTwoFactor(id) && !id.User.GetTwoFactorAuthentication() {
+		return denial{
+			Reason:   "two_factor_required",
+			Message:  "Your GitHub account must have two-factor authentication enabled to log in.",
+			HelpURL:  twoFactorHelpURL,
+			HelpText: "Set up two-factor authentication on GitHub",
+		}, true
+	}
+	if len(config.EmailDomains) > 0 && id.domainEmail() == "" {
+		return denial{
+			Reason: "email_domain_required",
+			Message: fmt.Sprintf(
+				"Your GitHub account must have a verified email address at one of: %s.",
+				strings.Join(config.EmailDomains, ", "),
+			),
+			HelpURL:  emailHelpURL,
+			HelpText: "Add and verify an email address on GitHub",
+		}, true
+	}
+	return denial{}, false
+}
+
+func emailInDomains(email string) bool {
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return false
+	}
+	for _, d := range config.EmailDomains {
+		if strings.EqualFold(domain, strings.TrimPrefix(d, "@")) {
+			return true
+		}
+	}
+	return false
+}
+
+type orgFilter struct {
+	Name  string   `json:"name"`
+	Teams []string `json:"teams"`
+}
+
+func (f orgFilter) validate() error {
+	for _, pattern := range f.Teams {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid team pattern for %s: %s", f.Name, pattern)
+		}
+	}
+	return nil
+}
+
+func (f orgFilter) allows(slug string) bool {
+	if len(f.Teams) == 0 {
+		return true
+	}
+	for _, pattern := range f.Teams {
+		if match, _ := path.Match(pattern, slug); match {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTeams drops teams outside of the configured organizations, so they
+// aren't stored in the session
+func filterTeams(teams []*github.Team) []*github.Team {
+	if len(config.Organizations) == 0 {
+		return teams
+	}
+
+	filters := map[string]orgFilter{}
+	for _, f := range config.Organizations {
+		filters[strings.ToLower(f.Name)] = f
+	}
+
+	var result []*github.Team
+	for _, t := range teams {
+		f, ok := filters[strings.ToLower(t.GetOrganization().GetLogin())]
+		if ok && f.allows(t.GetSlug()) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func configuredOrg(name string) bool {
+	for _, f := range config.Organizations {
+		if strings.EqualFold(f.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterOrgs drops org memberships outside of the configured organizations
+func filterOrgs(orgs []*github.Membership) []*github.Membership {
+	if len(config.Organizations) == 0 {
+		return orgs
+	}
+
+	var result []*github.Membership
+	for _, m := range orgs {
+		if configuredOrg(m.GetOrganization().GetLogin()) {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// hasRequiredMembership checks the user's orgs and teams, including those
+// inherited from child teams, against the
+// configured list of orgs and org/team pairs, at least one of which is needed
+// to log in
+func hasRequiredMembership(id identity) bool {
+	if len(config.RequireMember) == 0 {
+		return true
+	}
+
+	for _, required := range config.RequireMember {
+		org, team, hasTeam := strings.Cut(required, "/")
+		if !hasTeam {
+			for _, m := range id.Orgs {
+				if strings.EqualFold(m.GetOrganization().GetLogin(), org) {
+					return true
+				}
+			}
+			continue
+		}
+		for _, t := range append(id.Teams, id.InheritedTeams...) {
+			if strings.EqualFold(t.GetOrganization().GetLogin(), org) && strings.EqualFold(t.GetSlug(), team) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requiresTwoFactor checks if 2FA is required for the user, either globally
+// or because they're a member of one of the listed orgs
+func requiresTwoFactor(id identity) bool {
+	if !config.Require2FA {
+		return false
+	}
+	if len(config.Require2FAOrg) == 0 {
+		return true
+	}
+	for _, m := range id.Orgs {
+		for _, org := range config.Require2FAOrg {
+			if strings.EqualFold(m.GetOrganization().GetLogin(), org) {
+				return true
+			}
+		}
+	}
+	return false
+}
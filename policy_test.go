@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+)
+
+func testIdentity(twoFactor bool, emails ...string) identity {
+	org := &github.Organization{Login: github.String("acme")}
+	id := identity{
+		User:           &github.User{Login: github.String("alice"), TwoFactorAuthentication: github.Bool(twoFactor)},
+		Orgs:           []*github.Membership{{Organization: org}},
+		Teams:          []*github.Team{{Slug: github.String("dev"), Organization: org}},
+		InheritedTeams: []*github.Team{{Slug: github.String("eng"), Organization: org}},
+	}
+	for idx, e := range emails {
+		id.Emails = append(id.Emails, &github.UserEmail{
+			Email:    github.String(e),
+			Primary:  github.Bool(idx == 0),
+			Verified: github.Bool(true),
+		})
+	}
+	return id
+}
+
+func TestCheckLoginPolicy(t *testing.T) {
+	cases := []struct {
+		name   string
+		config configFile
+		id     identity
+		reason string
+	}{
+		{"no policy", configFile{}, testIdentity(false), ""},
+		{"required org", configFile{RequireMember: []string{"ACME"}}, testIdentity(false), ""},
+		{"required team", configFile{RequireMember: []string{"other", "acme/dev"}}, testIdentity(false), ""},
+		{"required parent team", configFile{RequireMember: []string{"acme/eng"}}, testIdentity(false), ""},
+		{"missing membership", configFile{RequireMember: []string{"other", "acme/ops"}}, testIdentity(true), "missing_required_membership"},
+		{"2fa enabled", configFile{Require2FA: true}, testIdentity(true), ""},
+		{"2fa disabled", configFile{Require2FA: true}, testIdentity(false), "two_factor_required"},
+		{"2fa required by org", configFile{Require2FA: true, Require2FAOrg: []string{"Acme"}}, testIdentity(false), "two_factor_required"},
+		{"2fa required by other org", configFile{Require2FA: true, Require2FAOrg: []string{"other"}}, testIdentity(false), ""},
+		{"membership checked before 2fa", configFile{RequireMember: []string{"other"}, Require2FA: true}, testIdentity(false), "missing_required_membership"},
+		{"primary email in domain", configFile{EmailDomains: []string{"example.com"}}, testIdentity(false, "alice@example.com"), ""},
+		{"secondary email in domain", configFile{EmailDomains: []string{"@Example.com"}}, testIdentity(false, "alice@gmail.com", "alice@example.com"), ""},
+		{"no email in domain", configFile{EmailDomains: []string{"example.com"}}, testIdentity(false, "alice@example.com.evil.net"), "email_domain_required"},
+		{"no emails", configFile{EmailDomains: []string{"example.com"}}, testIdentity(false), "email_domain_required"},
+		{"2fa checked before email", configFile{Require2FA: true, EmailDomains: []string{"example.com"}}, testIdentity(false), "two_factor_required"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config = &c.config
+			d, denied := checkLoginPolicy(c.id)
+			if denied != (c.reason != "") || d.Reason != c.reason {
+				t.Errorf("got denied=%v reason=%q, want %q", denied, d.Reason, c.reason)
+			}
+		})
+	}
+}
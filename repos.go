@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/akerl/github-auth-lambda/auth"
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/sync/errgroup"
+)
+
+// repoClient returns the client used to check permissions on a repo: the app's
+// installation for the owner when resolving memberships server-side, otherwise
+// the user's own token
+func repoClient(ctx context.Context, userClient *github.Client, repo string) (*github.Client, error) {
+	if !config.AppMembers {
+		return userClient, nil
+	}
+	owner, _, _ := strings.Cut(repo, "/")
+	installs, err := appInstallationIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for org, installID := range installs {
+		if strings.EqualFold(org, owner) {
+			return installationClient(ctx, installID)
+		}
+	}
+	return userClient, nil
+}
+
+// resolveRepoPermissions looks up the user's permission on each configured repo
+func resolveRepoPermissions(ctx context.Context, client *github.Client, login string) (map[string]string, error) {
+	var mutex sync.Mutex
+	result := map[string]string{}
+	g, ctx := errgroup.WithContext(ctx)
+	for _, repo := range config.Repositories {
+		repo := strings.ToLower(repo)
+		g.Go(func() error {
+			rc, err := repoClient(ctx, client, repo)
+			if err != nil {
+				return err
+			}
+			var permission string
+			callCtx, done := startCall(ctx, "github.repos.permission")
+			if rc == client {
+				permission, err = auth.GetOwnRepoPermission(callCtx, rc, repo)
+			} else {
+				permission, err = auth.GetRepoPermission(callCtx, rc, repo, login)
+			}
+			done(err)
+			if err != nil {
+				return err
+			}
+			mutex.Lock()
+			result[repo] = permission
+			mutex.Unlock()
+			return nil
+		})
+	}
+	err := g.Wait()
+	return result, err
+}
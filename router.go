@@ -5,27 +5,144 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"log"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/akerl/github-auth-lambda/audit"
+	"github.com/akerl/github-auth-lambda/auth"
+	"github.com/akerl/github-auth-lambda/metrics"
 	"github.com/akerl/github-auth-lambda/session"
 	"github.com/akerl/go-lambda/apigw/events"
+	"github.com/akerl/go-lambda/mux"
 	"github.com/google/go-github/v25/github"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
 )
 
-func fail(msg string) (events.Response, error) {
-	var id string
-	u, err := uuid.NewRandom()
-	if err == nil {
-		id = u.String()
-	} else {
-		id = "uuid_gen_failed"
+var (
+	requestMetrics *metrics.Recorder
+	requestCtx     = context.Background()
+)
+
+func route(name string, handler mux.HandleFunc) mux.HandleFunc {
+	return func(req events.Request) (events.Response, error) {
+		start := time.Now()
+		requestLog = logger.With(
+			"request_id", req.RequestContext.RequestID,
+			"route", name,
+			"host", req.Headers["Host"],
+		)
+		requestMetrics = metrics.New(config.MetricsNS, map[string]string{"Route": name})
+		ctx, cancel := requestContext()
+		defer cancel()
+		var span trace.Span
+		requestCtx, span = tracer.Start(
+			ctx,
+			name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", req.HTTPMethod),
+				attribute.String("url.path", req.Path),
+				attribute.String("server.address", req.Headers["Host"]),
+				attribute.String("aws.request_id", req.RequestContext.RequestID),
+			),
+		)
+		requestLog.Debug(
+			"request received",
+			"method", req.HTTPMethod,
+			"path", req.Path,
+			"headers", redactMap(req.Headers),
+			"query", redactMap(req.QueryStringParameters),
+		)
+
+		resp, err := handler(req)
+
+		level := slog.LevelInfo
+		attrs := []any{
+			"status", resp.StatusCode,
+			"outcome", outcome(resp.StatusCode),
+			"duration_ms", time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			level = slog.LevelError
+			attrs = append(attrs, "error", err)
+		} else if resp.StatusCode >= 500 {
+			level = slog.LevelError
+		}
+		requestLog.Log(requestCtx, level, "request complete", attrs...)
+
+		requestMetrics.SetDimension("Outcome", outcome(resp.StatusCode))
+		requestMetrics.Put("Requests", 1, metrics.Count)
+		requestMetrics.PutDuration("Latency", time.Since(start))
+		if err := requestMetrics.Flush(); err != nil {
+			requestLog.Error("failed to write metrics", "error", err)
+		}
+		if err := audit.Flush(auditSink); err != nil {
+			requestLog.Error("failed to write audit events", "error", err)
+		}
+
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		} else if resp.StatusCode >= 500 {
+			span.SetStatus(codes.Error, outcome(resp.StatusCode))
+		}
+		span.End()
+		flushTraces(context.Background())
+		return resp, err
 	}
-	log.Printf("%s %s", id, msg)
+}
+
+func fail(req events.Request, msg string) (events.Response, error) {
+	id := req.RequestContext.RequestID
+	if id == "" {
+		u, err := uuid.NewRandom()
+		if err == nil {
+			id = u.String()
+		} else {
+			id = "uuid_gen_failed"
+		}
+	}
+	requestLog.Error(msg, "error_id", id)
 	userError := fmt.Sprintf("server error while processing request: %s", id)
 	return events.Fail(userError)
 }
 
+func loginFail(req events.Request, sess session.Session, msg string) (events.Response, error) {
+	event := audit.NewEvent(audit.LoginFailure, req, sess)
+	event.Reason = msg
+	audit.Emit(auditSink, event)
+	return fail(req, msg)
+}
+
+func deny(req events.Request, sess session.Session, d denial) (events.Response, error) {
+	event := audit.NewEvent(audit.LoginDenied, req, sess)
+	event.Reason = d.Reason
+	audit.Emit(auditSink, event)
+	requestLog.Info("login denied", "login", sess.Login, "reason", d.Reason)
+
+	// Clear the session, in case this was a revalidation of an existing login
+	cookies, err := sm.WriteCookies(req, session.Session{})
+	if err != nil {
+		return fail(req, fmt.Sprintf("error encoding cookie: %s", err))
+	}
+
+	resp, err := renderPage(req, "/denied.html", 403, map[string]interface{}{
+		"login":     sess.Login,
+		"reason":    d.Message,
+		"help_url":  d.HelpURL,
+		"help_text": d.HelpText,
+	})
+	resp.MultiValueHeaders = map[string][]string{"Set-Cookie": cookies}
+	return resp, err
+}
+
 func success(req events.Request, sess session.Session) (events.Response, error) {
 	target := sess.Target
 	sess.Target = ""
@@ -38,36 +155,42 @@ func redirect(req events.Request, sess session.Session, target string) (events.R
 		respTarget = "https://" + req.Headers["Host"]
 	}
 
-	cookie, err := sm.Write(sess)
+	cookies, err := sm.WriteCookies(req, sess)
 	if err != nil {
-		return fail(fmt.Sprintf("error encoding cookie: %s", err))
+		return fail(req, fmt.Sprintf("error encoding cookie: %s", err))
 	}
 
 	return events.Response{
 		StatusCode: 303,
 		Headers: map[string]string{
-			"Location":   respTarget,
-			"Set-Cookie": cookie,
+			"Location": respTarget,
+		},
+		MultiValueHeaders: map[string][]string{
+			"Set-Cookie": cookies,
 		},
 	}, nil
 }
 
+// validTarget checks that a target is an https URL within the session's domain
+func validTarget(req events.Request, target string) bool {
+	u, err := url.Parse(target)
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	domain := strings.ToLower(strings.TrimPrefix(config.Domain, "."))
+	if domain == "" {
+		return host == strings.ToLower(req.Headers["Host"])
+	}
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
 func defaultHandler(req events.Request) (events.Response, error) {
 	return events.Redirect("https://"+req.Headers["Host"], 303)
 }
 
 func indexHandler(req events.Request) (events.Response, error) {
-	page, err := execTemplate("/index.html", req)
-	if err != nil {
-		return fail(fmt.Sprintf("failed to exec template: %s", err))
-	}
-	return events.Response{
-		StatusCode: 200,
-		Body:       page,
-		Headers: map[string]string{
-			"Content-Type": "text/html; charset=utf-8",
-		},
-	}, nil
+	return renderPage(req, "/index.html", 200, nil)
 }
 
 func faviconHandler(req events.Request) (events.Response, error) {
@@ -94,20 +217,34 @@ func missingHandler(_ events.Request) (events.Response, error) {
 func authHandler(req events.Request) (events.Response, error) {
 	sess, err := sm.Read(req)
 	if err != nil {
-		return fail(fmt.Sprintf("failed loading session cookie: %s", err))
+		return fail(req, fmt.Sprintf("failed loading session cookie: %s", err))
+	}
+
+	if target := req.QueryStringParameters["redirect"]; sess.Target == "" && validTarget(req, target) {
+		sess.Target = target
 	}
 
 	if sess.Login != "" {
-		return success(req, sess)
+		forced := req.QueryStringParameters["revalidate"] != ""
+		if !forced && !revalidationDue(sess) {
+			return success(req, sess)
+		}
+		ctx := context.WithValue(requestCtx, oauth2.HTTPClient, httpClient)
+		token, err := refreshToken(ctx, sess)
+		if err == nil {
+			return login(req, sess, token, true)
+		}
+		requestLog.Info("unable to revalidate session, restarting login", "login", sess.Login, "error", err)
+		sess = session.Session{Target: sess.Target}
 	}
 
-	if sess.Target == "" {
-		sess.Target = req.QueryStringParameters["redirect"]
+	if req.QueryStringParameters["sso"] == "skip" {
+		sess.SkipSSO = true
 	}
 
 	err = sess.SetNonce()
 	if err != nil {
-		return fail(fmt.Sprintf("failed to generate nonce: %s", err))
+		return fail(req, fmt.Sprintf("failed to generate nonce: %s", err))
 	}
 
 	url := oauthCfg.AuthCodeURL(sess.Nonce)
@@ -116,13 +253,17 @@ func authHandler(req events.Request) (events.Response, error) {
 }
 
 func logoutHandler(req events.Request) (events.Response, error) {
+	sess, err := sm.Read(req)
+	if err == nil && sess.Login != "" {
+		audit.Emit(auditSink, audit.NewEvent(audit.Logout, req, sess))
+	}
 	return redirect(req, session.Session{}, "")
 }
 
 func callbackHandler(req events.Request) (events.Response, error) {
 	sess, err := sm.Read(req)
 	if err != nil {
-		return fail(fmt.Sprintf("failed loading session cookie: %s", err))
+		return fail(req, fmt.Sprintf("failed loading session cookie: %s", err))
 	}
 
 	if sess.Login != "" {
@@ -132,39 +273,81 @@ func callbackHandler(req events.Request) (events.Response, error) {
 	actual := req.QueryStringParameters["state"]
 
 	if sess.Nonce == "" {
-		log.Print("callback hit with no nonce")
+		requestLog.Warn("callback hit with no nonce")
 		return events.Redirect("https://"+req.Headers["Host"], 303)
 	} else if sess.Nonce != actual {
-		return fail("nonce mismatch")
+		return loginFail(req, sess, "nonce mismatch")
 	}
 
+	ctx := context.WithValue(requestCtx, oauth2.HTTPClient, httpClient)
+
 	code := req.QueryStringParameters["code"]
-	token, err := oauthCfg.Exchange(context.Background(), code)
+	start := time.Now()
+	callCtx, done := startCall(ctx, "oauth.exchange")
+	token, err := oauthCfg.Exchange(callCtx, code)
+	done(err)
+	requestMetrics.PutDuration("TokenExchangeLatency", time.Since(start))
 	if err != nil {
-		return fail(fmt.Sprintf("there was an issue getting your token: %s", err))
+		return loginFail(req, sess, fmt.Sprintf("there was an issue getting your token: %s", err))
 	}
 
 	if !token.Valid() {
-		return fail("retreived invalid token")
+		return loginFail(req, sess, "retreived invalid token")
 	}
 
-	client := github.NewClient(oauthCfg.Client(context.Background(), token))
+	return login(req, sess, token, false)
+}
+
+// login resolves the user's identity with their token and, if they pass the
+// login policy, issues the session. This runs both for new logins and when
+// revalidating an existing session.
+func login(req events.Request, sess session.Session, token *oauth2.Token, revalidating bool) (events.Response, error) {
+	ctx := context.WithValue(requestCtx, oauth2.HTTPClient, httpClient)
+	client := github.NewClient(oauthCfg.Client(ctx, token))
 
-	user, _, err := client.Users.Get(context.Background(), "")
-	if err != nil {
-		return fail(fmt.Sprintf("error getting name: %s", err))
+	id, err := collectIdentity(ctx, client)
+	if err != nil && revalidating {
+		requestLog.Info("unable to revalidate session, restarting login", "login", sess.Login, "error", err)
+		return redirect(req, session.Session{Target: sess.Target}, "https://"+req.Headers["Host"]+"/auth")
+	} else if err != nil {
+		return loginFail(req, sess, err.Error())
 	}
-	sess.Login = *user.Login
+	id.apply(&sess)
 
-	teams, _, err := client.Teams.ListUserTeams(context.Background(), &github.ListOptions{})
+	if len(sess.SSORequired) > 0 && !sess.SkipSSO && !revalidating {
+		requestLog.Info("sso authorization required", "login", sess.Login, "orgs", sess.SSORequired)
+		links := make([]map[string]string, len(sess.SSORequired))
+		for idx, org := range sess.SSORequired {
+			links[idx] = map[string]string{"org": org, "url": id.SSOOrgs[org]}
+		}
+		return renderPage(req, "/sso.html", 200, map[string]interface{}{
+			"login":     sess.Login,
+			"sso_links": links,
+		})
+	}
+	sess.SkipSSO = false
+
+	if d, denied := checkLoginPolicy(id); denied {
+		return deny(req, sess, d)
+	}
+
+	sess.Roles, err = auth.ComputeRoles(config.Roles, sess)
 	if err != nil {
-		return fail(fmt.Sprintf("error getting teams: %s", err))
+		return loginFail(req, sess, fmt.Sprintf("error computing roles: %s", err))
 	}
-	sess.Memberships = make(map[string][]string)
-	for _, t := range teams {
-		org := *t.Organization.Login
-		sess.Memberships[org] = append(sess.Memberships[org], *t.Slug)
+
+	sess.ValidatedAt = time.Now().Unix()
+	if storeTokens() {
+		sess.SealedToken, err = sealToken(token)
+		if err != nil {
+			return loginFail(req, sess, fmt.Sprintf("error sealing token: %s", err))
+		}
 	}
 
+	eventType := audit.LoginSuccess
+	if revalidating {
+		eventType = audit.Revalidated
+	}
+	audit.Emit(auditSink, audit.NewEvent(eventType, req, sess))
 	return success(req, sess)
 }
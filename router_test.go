@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/akerl/go-lambda/apigw/events"
+)
+
+func TestValidTarget(t *testing.T) {
+	req := events.Request{Headers: map[string]string{"Host": "auth.example.com"}}
+	cases := []struct {
+		domain string
+		target string
+		want   bool
+	}{
+		{".example.com", "https://app.example.com/path", true},
+		{"example.com", "https://example.com/", true},
+		{".example.com", "http://app.example.com/path", false},
+		{".example.com", "https://app.example.com.evil.net/", false},
+		{".example.com", "https://badexample.com/", false},
+		{".example.com", "javascript:alert(1)", false},
+		{"", "https://auth.example.com/", true},
+		{"", "https://app.example.com/", false},
+	}
+	for _, c := range cases {
+		config = &configFile{Domain: c.domain}
+		if got := validTarget(req, c.target); got != c.want {
+			t.Errorf("validTarget(%q) with domain %q = %v, want %v", c.target, c.domain, got, c.want)
+		}
+	}
+}
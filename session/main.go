@@ -3,18 +3,171 @@ package session
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/akerl/go-lambda/apigw/events"
 	"github.com/gorilla/securecookie"
 )
 
-// Session demribes the Session object
+// Roles a user can hold in an org or team
+const (
+	RoleMember     = "member"
+	RoleAdmin      = "admin"
+	RoleMaintainer = "maintainer"
+)
+
+// RepoPermissionLevels lists repository permissions from least to most access
+var RepoPermissionLevels = []string{"none", "read", "triage", "write", "maintain", "admin"}
+
+// RepoPermissionRank returns the position of a permission in RepoPermissionLevels,
+// or 0 if it isn't recognized
+func RepoPermissionRank(permission string) int {
+	for idx, level := range RepoPermissionLevels {
+		if level == permission {
+			return idx
+		}
+	}
+	return 0
+}
+
+// OrgMembership describes the user's role in an org and its teams
+type OrgMembership struct {
+	ID    int64                     `json:"id"`
+	Role  string                    `json:"role"`
+	Teams map[string]TeamMembership `json:"teams"`
+}
+
+// TeamMembership describes the user's role in a team
+type TeamMembership struct {
+	ID        int64  `json:"id"`
+	Role      string `json:"role"`
+	Inherited bool   `json:"inherited"`
+}
+
+// Session demribes the Session object. Memberships and InheritedMemberships
+// are deprecated in favor of Orgs, and are only still written for apps using
+// older versions of this package.
 type Session struct {
-	Nonce       string              `json:"state"`
-	Login       string              `json:"login"`
-	Memberships map[string][]string `json:"memberships"`
-	Target      string              `json:"target"`
+	Nonce                string                   `json:"state"`
+	Login                string                   `json:"login"`
+	UserID               int64                    `json:"user_id"`
+	Name                 string                   `json:"name"`
+	AvatarURL            string                   `json:"avatar_url"`
+	Email                string                   `json:"email"`
+	DomainEmail          string                   `json:"domain_email"`
+	Memberships          map[string][]string      `json:"memberships"`
+	InheritedMemberships map[string][]string      `json:"inherited_memberships"`
+	Orgs                 map[string]OrgMembership `json:"orgs"`
+	RepoPermissions      map[string]string        `json:"repo_permissions"`
+	Roles                map[string][]string      `json:"roles"`
+	SSORequired          []string                 `json:"sso_required"`
+	SkipSSO              bool                     `json:"skip_sso"`
+	SealedToken          string                   `json:"sealed_token"`
+	ValidatedAt          int64                    `json:"validated_at"`
+	Target               string                   `json:"target"`
+}
+
+// upgrade fills in Orgs for sessions written before roles were tracked
+func (s *Session) upgrade() {
+	if s.Orgs != nil || len(s.Memberships) == 0 {
+		return
+	}
+	s.Orgs = map[string]OrgMembership{}
+	for org, teams := range s.Memberships {
+		om := OrgMembership{Teams: map[string]TeamMembership{}}
+		for _, t := range teams {
+			om.Teams[t] = TeamMembership{}
+		}
+		for _, t := range s.InheritedMemberships[org] {
+			om.Teams[t] = TeamMembership{Inherited: true}
+		}
+		s.Orgs[org] = om
+	}
+}
+
+// OrgRole returns the user's role in an org, or an empty string if they
+// aren't a member or the role is unknown
+func (s *Session) OrgRole(org string) string {
+	return s.Orgs[org].Role
+}
+
+// TeamRole returns the user's role in a team, or an empty string if they
+// aren't a direct member or the role is unknown
+func (s *Session) TeamRole(org, team string) string {
+	tm, ok := s.Orgs[org].Teams[team]
+	if !ok || tm.Inherited {
+		return ""
+	}
+	return tm.Role
+}
+
+// OrgByID returns the name of the org with the given ID, if the user is a member
+func (s *Session) OrgByID(id int64) (string, bool) {
+	for name, om := range s.Orgs {
+		if om.ID != 0 && om.ID == id {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// TeamByID returns the org, slug, and membership of the team with the given ID,
+// if the user is a direct or inherited member
+func (s *Session) TeamByID(id int64) (string, string, TeamMembership, bool) {
+	for org, om := range s.Orgs {
+		for slug, tm := range om.Teams {
+			if tm.ID != 0 && tm.ID == id {
+				return org, slug, tm, true
+			}
+		}
+	}
+	return "", "", TeamMembership{}, false
+}
+
+// HasRepoPermission checks if the user has at least the given permission on
+// a repo, named as "owner/repo"
+func (s *Session) HasRepoPermission(repo, permission string) bool {
+	actual, ok := s.RepoPermissions[strings.ToLower(repo)]
+	if !ok {
+		return false
+	}
+	required := RepoPermissionRank(permission)
+	return required > 0 && RepoPermissionRank(actual) >= required
+}
+
+// HasRole checks if the user was granted a role within an application's namespace
+func (s *Session) HasRole(app, role string) bool {
+	for _, r := range s.Roles[app] {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOrgAdmin checks if the user is an owner of an org
+func (s *Session) IsOrgAdmin(org string) bool {
+	return s.OrgRole(org) == RoleAdmin
+}
+
+// IsTeamMaintainer checks if the user is a maintainer of a team
+func (s *Session) IsTeamMaintainer(org, team string) bool {
+	return s.TeamRole(org, team) == RoleMaintainer
+}
+
+// InTeam checks if the user is a direct member of a team
+func (s *Session) InTeam(org, team string) bool {
+	tm, ok := s.Orgs[org].Teams[team]
+	return ok && !tm.Inherited
+}
+
+// InTeamOrChild checks if the user is a member of a team or any of its child teams
+func (s *Session) InTeamOrChild(org, team string) bool {
+	_, ok := s.Orgs[org].Teams[team]
+	return ok
 }
 
 // SetNonce sets the nonce for the Session object
@@ -28,6 +181,11 @@ func (s *Session) SetNonce() error {
 	return nil
 }
 
+// ChunkSize is the largest cookie value written before splitting the session
+// across multiple cookies, leaving room for the name and attributes within
+// the ~4KB browser limit
+const ChunkSize = 3800
+
 // Manager handles encoding/decoding cookies
 type Manager struct {
 	Name     string
@@ -47,6 +205,7 @@ func (m *Manager) initCodec() {
 		m.EncKey,
 	)
 	m.codec.MaxAge(m.Lifetime)
+	m.codec.MaxLength(0)
 }
 
 func (m *Manager) decode(name, cookie string, sess *Session) error {
@@ -59,21 +218,51 @@ func (m *Manager) encode(name string, sess Session) (string, error) {
 	return m.codec.Encode(name, sess)
 }
 
-// Read reads a cookie from a request
-func (m *Manager) Read(req events.Request) (Session, error) {
+func (m *Manager) chunkName(idx int) string {
+	return fmt.Sprintf("%s.%d", m.Name, idx)
+}
+
+func requestCookies(req events.Request) []*http.Cookie {
 	header := http.Header{}
 	header.Add("Cookie", req.Headers["Cookie"])
 	request := http.Request{Header: header}
-	cookie, err := request.Cookie(m.Name)
-	if err == http.ErrNoCookie {
+	return request.Cookies()
+}
+
+// readValue returns the encoded session, reassembling it from chunks if needed
+func (m *Manager) readValue(req events.Request) (string, bool) {
+	values := map[string]string{}
+	for _, c := range requestCookies(req) {
+		values[c.Name] = c.Value
+	}
+
+	if _, ok := values[m.chunkName(0)]; !ok {
+		value, ok := values[m.Name]
+		return value, ok
+	}
+
+	var b strings.Builder
+	for idx := 0; ; idx++ {
+		chunk, ok := values[m.chunkName(idx)]
+		if !ok {
+			break
+		}
+		b.WriteString(chunk)
+	}
+	return b.String(), true
+}
+
+// Read reads a cookie from a request
+func (m *Manager) Read(req events.Request) (Session, error) {
+	value, found := m.readValue(req)
+	if !found {
 		return Session{}, nil
-	} else if err != nil {
-		return Session{}, err
 	}
 
 	s := Session{}
-	err = m.decode(m.Name, cookie.Value, &s)
+	err := m.decode(m.Name, value, &s)
 	if err == nil {
+		s.upgrade()
 		return s, nil
 	}
 	if mError, ok := err.(securecookie.Error); ok && mError.IsDecode() {
@@ -82,22 +271,71 @@ func (m *Manager) Read(req events.Request) (Session, error) {
 	return Session{}, err
 }
 
-// Write encodes a cookie from a Session
+func (m *Manager) cookie(name, value string, maxAge int) string {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Secure:   true,
+		HttpOnly: true,
+		MaxAge:   maxAge,
+		Domain:   m.Domain,
+	}
+	return cookie.String()
+}
+
+// Write encodes a Session into a single cookie. Sessions too large for one
+// cookie should be written with WriteCookies instead.
 func (m *Manager) Write(sess Session) (string, error) {
 	encoded, err := m.encode(m.Name, sess)
 	if err != nil {
 		return "", err
 	}
+	return m.cookie(m.Name, encoded, m.Lifetime), nil
+}
 
-	cookie := &http.Cookie{
-		Name:     m.Name,
-		Value:    encoded,
-		Path:     "/",
-		Secure:   true,
-		HttpOnly: true,
-		MaxAge:   m.Lifetime,
-		Domain:   m.Domain,
+// WriteCookies encodes a Session into a single cookie when it fits, or splits
+// it across numbered chunk cookies when it doesn't. Any cookies on the request
+// left over from a previous session written in the other form are expired.
+func (m *Manager) WriteCookies(req events.Request, sess Session) ([]string, error) {
+	encoded, err := m.encode(m.Name, sess)
+	if err != nil {
+		return []string{}, err
+	}
+
+	var cookies []string
+	written := map[string]bool{}
+	if len(encoded) <= ChunkSize {
+		cookies = append(cookies, m.cookie(m.Name, encoded, m.Lifetime))
+		written[m.Name] = true
+	} else {
+		for idx := 0; len(encoded) > 0; idx++ {
+			size := ChunkSize
+			if len(encoded) < size {
+				size = len(encoded)
+			}
+			name := m.chunkName(idx)
+			cookies = append(cookies, m.cookie(name, encoded[:size], m.Lifetime))
+			written[name] = true
+			encoded = encoded[size:]
+		}
+	}
+
+	prefix := m.Name + "."
+	for _, c := range requestCookies(req) {
+		if written[c.Name] {
+			continue
+		}
+		if c.Name == m.Name {
+			cookies = append(cookies, m.cookie(c.Name, "", -1))
+			continue
+		}
+		if suffix, ok := strings.CutPrefix(c.Name, prefix); ok {
+			if _, err := strconv.Atoi(suffix); err == nil {
+				cookies = append(cookies, m.cookie(c.Name, "", -1))
+			}
+		}
 	}
 
-	return cookie.String(), nil
+	return cookies, nil
 }
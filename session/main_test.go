@@ -0,0 +1,220 @@
+package session
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/akerl/go-lambda/apigw/events"
+)
+
+func testManager() *Manager {
+	return &Manager{
+		Name:     "session",
+		SignKey:  []byte("0123456789abcdef0123456789abcdef"),
+		EncKey:   []byte("0123456789abcdef0123456789abcdef"),
+		Lifetime: 3600,
+	}
+}
+
+// parseCookies splits Set-Cookie values into the live and expired cookies
+func parseCookies(setCookies []string) (map[string]string, []string) {
+	resp := http.Response{Header: http.Header{"Set-Cookie": setCookies}}
+	live := map[string]string{}
+	var expired []string
+	for _, c := range resp.Cookies() {
+		if c.MaxAge < 0 {
+			expired = append(expired, c.Name)
+		} else {
+			live[c.Name] = c.Value
+		}
+	}
+	return live, expired
+}
+
+func requestWith(cookies map[string]string) events.Request {
+	var parts []string
+	for name, value := range cookies {
+		parts = append(parts, name+"="+value)
+	}
+	return events.Request{Headers: map[string]string{"Cookie": strings.Join(parts, "; ")}}
+}
+
+func largeSession(teams int) Session {
+	om := OrgMembership{Role: RoleMember, Teams: map[string]TeamMembership{}}
+	for i := 0; i < teams; i++ {
+		om.Teams[fmt.Sprintf("team-%03d-%s", i, strings.Repeat("x", 40))] = TeamMembership{}
+	}
+	return Session{Login: "alice", Orgs: map[string]OrgMembership{"org": om}}
+}
+
+func TestWriteCookiesRoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		sess     Session
+		existing map[string]string
+		chunked  bool
+		expired  []string
+	}{
+		{
+			name: "small session uses a single cookie",
+			sess: Session{Login: "alice"},
+		},
+		{
+			name:    "large session is chunked",
+			sess:    largeSession(200),
+			chunked: true,
+		},
+		{
+			name:     "small session expires old chunks",
+			sess:     Session{Login: "alice"},
+			existing: map[string]string{"session.0": "a", "session.1": "b", "other": "c"},
+			expired:  []string{"session.0", "session.1"},
+		},
+		{
+			name:     "large session expires the single cookie and extra chunks",
+			sess:     largeSession(200),
+			existing: map[string]string{"session": "a", "session.40": "b", "session.x": "c"},
+			chunked:  true,
+			expired:  []string{"session", "session.40"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := testManager()
+			setCookies, err := m.WriteCookies(requestWith(c.existing), c.sess)
+			if err != nil {
+				t.Fatal(err)
+			}
+			live, expired := parseCookies(setCookies)
+
+			_, single := live["session"]
+			_, chunk := live["session.0"]
+			if c.chunked && (single || !chunk) {
+				t.Errorf("expected only chunk cookies, got %v", live)
+			}
+			if !c.chunked && (!single || chunk) {
+				t.Errorf("expected a single cookie, got %v", live)
+			}
+			for _, v := range live {
+				if len(v) > ChunkSize {
+					t.Errorf("cookie value of %d bytes exceeds ChunkSize", len(v))
+				}
+			}
+			if !sameSet(expired, c.expired) {
+				t.Errorf("expired %v, want %v", expired, c.expired)
+			}
+
+			sess, err := m.Read(requestWith(live))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sess.Login != c.sess.Login || len(sess.Orgs["org"].Teams) != len(c.sess.Orgs["org"].Teams) {
+				t.Errorf("read back %s with %d teams", sess.Login, len(sess.Orgs["org"].Teams))
+			}
+		})
+	}
+}
+
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := map[string]bool{}
+	for _, s := range a {
+		seen[s] = true
+	}
+	for _, s := range b {
+		if !seen[s] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReadUpgradesLegacySessions(t *testing.T) {
+	cases := []struct {
+		name string
+		sess Session
+	}{
+		{
+			name: "legacy maps only",
+			sess: Session{
+				Login:                "alice",
+				Memberships:          map[string][]string{"org": {"dev"}},
+				InheritedMemberships: map[string][]string{"org": {"parent"}},
+			},
+		},
+		{
+			name: "orgs and legacy maps",
+			sess: Session{
+				Login:                "alice",
+				Memberships:          map[string][]string{"org": {"dev"}},
+				InheritedMemberships: map[string][]string{"org": {"parent"}},
+				Orgs: map[string]OrgMembership{"org": {Teams: map[string]TeamMembership{
+					"dev":    {Role: RoleMaintainer},
+					"parent": {Inherited: true},
+				}}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := testManager()
+			setCookies, err := m.WriteCookies(events.Request{}, c.sess)
+			if err != nil {
+				t.Fatal(err)
+			}
+			live, _ := parseCookies(setCookies)
+			read, err := m.Read(requestWith(live))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !read.InTeam("org", "dev") || read.InTeam("org", "parent") || !read.InTeamOrChild("org", "parent") {
+				t.Errorf("unexpected team membership: %+v", read.Orgs)
+			}
+			if len(read.Memberships["org"]) != 1 || len(read.InheritedMemberships["org"]) != 1 {
+				t.Errorf("legacy maps not kept: %v %v", read.Memberships, read.InheritedMemberships)
+			}
+		})
+	}
+}
+
+func TestHasRepoPermission(t *testing.T) {
+	sess := Session{RepoPermissions: map[string]string{
+		"org/read":     "read",
+		"org/triage":   "triage",
+		"org/write":    "write",
+		"org/maintain": "maintain",
+		"org/admin":    "admin",
+		"org/none":     "none",
+		"org/odd":      "custom-role",
+	}}
+	cases := []struct {
+		repo       string
+		permission string
+		want       bool
+	}{
+		{"org/read", "read", true},
+		{"org/read", "triage", false},
+		{"org/triage", "read", true},
+		{"org/write", "triage", true},
+		{"org/write", "maintain", false},
+		{"org/maintain", "write", true},
+		{"org/admin", "admin", true},
+		{"org/none", "read", false},
+		{"org/odd", "read", false},
+		{"ORG/Admin", "read", true},
+		{"org/missing", "read", false},
+		{"org/admin", "none", false},
+		{"org/admin", "bogus", false},
+	}
+	for _, c := range cases {
+		if got := sess.HasRepoPermission(c.repo, c.permission); got != c.want {
+			t.Errorf("HasRepoPermission(%q, %q) = %v, want %v", c.repo, c.permission, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v25/github"
+)
+
+const ssoHeader = "X-GitHub-SSO"
+
+type ssoTrackerKeyType struct{}
+
+var ssoTrackerKey = ssoTrackerKeyType{}
+
+// ssoTracker records orgs that withheld results because the token hasn't
+// been authorized for their SAML SSO
+type ssoTracker struct {
+	orgIDs map[int64]bool
+	urls   map[string]string
+	mutex  sync.Mutex
+}
+
+func newSSOTracker() *ssoTracker {
+	return &ssoTracker{orgIDs: map[int64]bool{}, urls: map[string]string{}}
+}
+
+func withSSOTracker(ctx context.Context, t *ssoTracker) context.Context {
+	return context.WithValue(ctx, ssoTrackerKey, t)
+}
+
+// record parses an X-GitHub-SSO header, which is either
+// "partial-results; organizations=1,2" or "required; url=https://..."
+func (t *ssoTracker) record(header string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	kind, params, _ := strings.Cut(header, ";")
+	params = strings.TrimSpace(params)
+	switch strings.TrimSpace(kind) {
+	case "partial-results":
+		ids := strings.TrimPrefix(params, "organizations=")
+		for _, raw := range strings.Split(ids, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+			if err == nil {
+				t.orgIDs[id] = true
+			}
+		}
+	case "required":
+		raw := strings.TrimPrefix(params, "url=")
+		u, err := url.Parse(raw)
+		if err != nil {
+			return
+		}
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) >= 2 && parts[0] == "orgs" {
+			t.urls[parts[1]] = raw
+		}
+	}
+}
+
+// resolve returns a map of org names to their SSO authorization URLs
+func (t *ssoTracker) resolve(ctx context.Context, client *github.Client) (map[string]string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	result := map[string]string{}
+	for org, u := range t.urls {
+		result[org] = u
+	}
+	for id := range t.orgIDs {
+		callCtx, done := startCall(ctx, "github.orgs.get")
+		org, resp, err := client.Organizations.GetByID(callCtx, id)
+		done(err)
+		if err != nil {
+			return nil, err
+		}
+		recordRate(resp)
+		if _, ok := result[org.GetLogin()]; !ok {
+			result[org.GetLogin()] = fmt.Sprintf("https://github.com/orgs/%s/sso", org.GetLogin())
+		}
+	}
+	return result, nil
+}
+
+// ssoTransport feeds X-GitHub-SSO headers to the tracker on the request context
+type ssoTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *ssoTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	tracker, _ := req.Context().Value(ssoTrackerKey).(*ssoTracker)
+	if header := resp.Header.Get(ssoHeader); tracker != nil && header != "" {
+		tracker.record(header)
+	}
+	return resp, nil
+}
+
+// isSSOError checks if a GitHub API error was caused by SAML SSO enforcement
+func isSSOError(err error) bool {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.Header.Get(ssoHeader) != ""
+}
+
+// pendingSSO returns the SSO orgs that should be authorized before logging in,
+// ignoring orgs outside of the configured organizations
+func pendingSSO(orgs map[string]string) []string {
+	var names []string
+	for org := range orgs {
+		if len(config.Organizations) > 0 && !configuredOrg(org) {
+			continue
+		}
+		names = append(names, org)
+	}
+	sort.Strings(names)
+	return names
+}
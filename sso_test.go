@@ -0,0 +1,65 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSSOTrackerRecord(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		orgIDs map[int64]bool
+		urls   map[string]string
+	}{
+		{
+			name:   "partial results",
+			header: "partial-results; organizations=21955855,20582480",
+			orgIDs: map[int64]bool{21955855: true, 20582480: true},
+			urls:   map[string]string{},
+		},
+		{
+			name:   "partial results with spaces and junk",
+			header: " partial-results ;organizations=1, x ,2",
+			orgIDs: map[int64]bool{1: true, 2: true},
+			urls:   map[string]string{},
+		},
+		{
+			name:   "required",
+			header: "required; url=https://github.com/orgs/example/sso?authorization_request=abc",
+			orgIDs: map[int64]bool{},
+			urls:   map[string]string{"example": "https://github.com/orgs/example/sso?authorization_request=abc"},
+		},
+		{
+			name:   "required without an org url",
+			header: "required; url=https://github.com/login",
+			orgIDs: map[int64]bool{},
+			urls:   map[string]string{},
+		},
+		{
+			name:   "unknown kind",
+			header: "something-else; organizations=1",
+			orgIDs: map[int64]bool{},
+			urls:   map[string]string{},
+		},
+		{
+			name:   "empty",
+			header: "",
+			orgIDs: map[int64]bool{},
+			urls:   map[string]string{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tracker := newSSOTracker()
+			tracker.record(c.header)
+			if !reflect.DeepEqual(tracker.orgIDs, c.orgIDs) {
+				t.Errorf("orgIDs = %v, want %v", tracker.orgIDs, c.orgIDs)
+			}
+			if !reflect.DeepEqual(tracker.urls, c.urls) {
+				t.Errorf("urls = %v, want %v", tracker.urls, c.urls)
+			}
+		})
+	}
+}
@@ -137,7 +137,544 @@ var static *FileSystem
 func init() {
 	static = &FileSystem{
 		files: map[string]File{
-			"/favicon.ico": File{
+			"/access.html.hbs": File{
+				data: []byte{
+					0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x68, 0x74,
+					0x6d, 0x6c, 0x3e, 0x0a, 0x3c, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20,
+					0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x3d, 0x22, 0x75, 0x74, 0x66,
+					0x2d, 0x38, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2d,
+					0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x78, 0x2d, 0x75, 0x61, 0x2d,
+					0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x20,
+					0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x69, 0x65, 0x3d,
+					0x65, 0x64, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74,
+					0x70, 0x2d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x43, 0x6f, 0x6e,
+					0x74, 0x65, 0x6e, 0x74, 0x2d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+					0x79, 0x2d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x20, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x6e, 0x6f, 0x6e, 0x65,
+					0x27, 0x3b, 0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2d, 0x73, 0x72,
+					0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x20, 0x3b, 0x20, 0x63,
+					0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27,
+					0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x69, 0x6d, 0x67, 0x2d, 0x73,
+					0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x73,
+					0x74, 0x79, 0x6c, 0x65, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65,
+					0x6c, 0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+					0x66, 0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+					0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x20, 0x3b, 0x20, 0x66,
+					0x6f, 0x6e, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c,
+					0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66,
+					0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+					0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x4f, 0x41,
+					0x75, 0x74, 0x68, 0x20, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x3c,
+					0x2f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65,
+					0x6c, 0x3d, 0x22, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x20, 0x68, 0x72, 0x65,
+					0x66, 0x3d, 0x22, 0x2f, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x2e,
+					0x69, 0x63, 0x6f, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65, 0x6c, 0x3d,
+					0x22, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22,
+					0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x74, 0x65, 0x78, 0x74, 0x2f,
+					0x63, 0x73, 0x73, 0x22, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x68,
+					0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66, 0x6f, 0x6e, 0x74, 0x73,
+					0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e,
+					0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x73, 0x73, 0x3f, 0x66, 0x61, 0x6d, 0x69,
+					0x6c, 0x79, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2b, 0x53, 0x61,
+					0x6e, 0x73, 0x2b, 0x50, 0x72, 0x6f, 0x3a, 0x33, 0x30, 0x30, 0x2c, 0x34,
+					0x30, 0x30, 0x2c, 0x36, 0x30, 0x30, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x62, 0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x64, 0x69, 0x76, 0x20, 0x63, 0x6c, 0x61,
+					0x73, 0x73, 0x3d, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x73, 0x75, 0x62,
+					0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x68, 0x31, 0x20, 0x63, 0x6c, 0x61, 0x73, 0x73,
+					0x3d, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x3e, 0x41, 0x63, 0x63,
+					0x65, 0x73, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+					0x64, 0x3c, 0x2f, 0x68, 0x31, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c,
+					0x70, 0x3e, 0x59, 0x6f, 0x75, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+					0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
+					0x73, 0x20, 0x74, 0x6f, 0x20, 0x7b, 0x7b, 0x20, 0x61, 0x63, 0x63, 0x65,
+					0x73, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x20, 0x7c, 0x20, 0x65, 0x73, 0x63,
+					0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62,
+					0x65, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20,
+					0x69, 0x74, 0x73, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
+					0x73, 0x2e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c,
+					0x70, 0x3e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x20,
+					0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x64, 0x6f,
+					0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x20,
+					0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x62, 0x79, 0x20, 0x69, 0x74,
+					0x73, 0x65, 0x6c, 0x66, 0x3a, 0x20, 0x77, 0x68, 0x6f, 0x65, 0x76, 0x65,
+					0x72, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x73, 0x20, 0x7b, 0x7b,
+					0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x20,
+					0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20,
+					0x73, 0x74, 0x69, 0x6c, 0x6c, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x20,
+					0x74, 0x6f, 0x20, 0x61, 0x64, 0x64, 0x20, 0x79, 0x6f, 0x75, 0x20, 0x74,
+					0x6f, 0x20, 0x61, 0x20, 0x74, 0x65, 0x61, 0x6d, 0x20, 0x6f, 0x72, 0x20,
+					0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x74, 0x20, 0x61, 0x63, 0x63, 0x65,
+					0x70, 0x74, 0x73, 0x2e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x25, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x61, 0x63, 0x63, 0x65,
+					0x73, 0x73, 0x2e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x21, 0x3d,
+					0x20, 0x22, 0x22, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x4f, 0x6e, 0x63, 0x65, 0x20,
+					0x74, 0x68, 0x65, 0x79, 0x20, 0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x79,
+					0x6f, 0x75, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x3c, 0x61, 0x20, 0x68, 0x72,
+					0x65, 0x66, 0x3d, 0x22, 0x7b, 0x7b, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
+					0x73, 0x2e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x7c, 0x20, 0x65,
+					0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x70, 0x61, 0x67, 0x65, 0x20, 0x79, 0x6f, 0x75, 0x20, 0x77, 0x65, 0x72,
+					0x65, 0x20, 0x76, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x3c, 0x2f,
+					0x61, 0x3e, 0x2e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x2d,
+					0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x68, 0x31, 0x20, 0x63,
+					0x6c, 0x61, 0x73, 0x73, 0x3d, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
+					0x3e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x61, 0x63, 0x63,
+					0x65, 0x73, 0x73, 0x3c, 0x2f, 0x68, 0x31, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x70, 0x3e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+					0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x7b, 0x7b,
+					0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x20,
+					0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20,
+					0x61, 0x73, 0x20, 0x7b, 0x7b, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+					0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x7c, 0x20, 0x65, 0x73, 0x63,
+					0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x2e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x61,
+					0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x6e, 0x65, 0x65, 0x64, 0x2e, 0x73,
+					0x69, 0x7a, 0x65, 0x20, 0x3e, 0x20, 0x30, 0x20, 0x2d, 0x25, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x41,
+					0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+					0x65, 0x73, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x3a,
+					0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x66, 0x6f,
+					0x72, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x63,
+					0x63, 0x65, 0x73, 0x73, 0x2e, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x2d, 0x25,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69, 0x3e, 0x7b, 0x7b,
+					0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x7c, 0x20, 0x65, 0x73, 0x63, 0x61,
+					0x70, 0x65, 0x20, 0x7d, 0x7d, 0x3c, 0x2f, 0x6c, 0x69, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x66, 0x6f, 0x72, 0x20, 0x2d, 0x25,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f,
+					0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20,
+					0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x6d, 0x65, 0x74, 0x68,
+					0x6f, 0x64, 0x3d, 0x22, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x20, 0x61, 0x63,
+					0x74, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73,
+					0x73, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x3c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3d,
+					0x22, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x20, 0x6e, 0x61, 0x6d,
+					0x65, 0x3d, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x20,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x3d, 0x22, 0x7b, 0x7b, 0x20, 0x70, 0x61,
+					0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x69, 0x6e, 0x70, 0x75,
+					0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x68, 0x69, 0x64, 0x64,
+					0x65, 0x6e, 0x22, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x22, 0x73, 0x69,
+					0x67, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3d, 0x22, 0x7b, 0x7b,
+					0x20, 0x73, 0x69, 0x67, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x69, 0x6e, 0x70, 0x75, 0x74,
+					0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x68, 0x69, 0x64, 0x64, 0x65,
+					0x6e, 0x22, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x22, 0x63, 0x73, 0x72,
+					0x66, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3d, 0x22, 0x7b, 0x7b,
+					0x20, 0x63, 0x73, 0x72, 0x66, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x6c,
+					0x61, 0x62, 0x65, 0x6c, 0x20, 0x66, 0x6f, 0x72, 0x3d, 0x22, 0x72, 0x65,
+					0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3e, 0x57, 0x68, 0x79, 0x20, 0x64, 0x6f,
+					0x20, 0x79, 0x6f, 0x75, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x61, 0x63,
+					0x63, 0x65, 0x73, 0x73, 0x3f, 0x3c, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+					0x3e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x74, 0x65, 0x78, 0x74, 0x61, 0x72,
+					0x65, 0x61, 0x20, 0x69, 0x64, 0x3d, 0x22, 0x72, 0x65, 0x61, 0x73, 0x6f,
+					0x6e, 0x22, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x22, 0x72, 0x65, 0x61,
+					0x73, 0x6f, 0x6e, 0x22, 0x20, 0x72, 0x6f, 0x77, 0x73, 0x3d, 0x22, 0x34,
+					0x22, 0x20, 0x63, 0x6f, 0x6c, 0x73, 0x3d, 0x22, 0x36, 0x30, 0x22, 0x20,
+					0x6d, 0x61, 0x78, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3d, 0x22, 0x31,
+					0x30, 0x30, 0x30, 0x22, 0x3e, 0x3c, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x61,
+					0x72, 0x65, 0x61, 0x3e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x62, 0x75, 0x74,
+					0x74, 0x6f, 0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x73, 0x75,
+					0x62, 0x6d, 0x69, 0x74, 0x22, 0x3e, 0x53, 0x65, 0x6e, 0x64, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3c, 0x2f, 0x62, 0x75, 0x74, 0x74,
+					0x6f, 0x6e, 0x3e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x3c, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20,
+					0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70,
+					0x3e, 0x3c, 0x61, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x2f, 0x22,
+					0x3e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x68, 0x6f, 0x6d, 0x65, 0x20, 0x70, 0x61, 0x67, 0x65,
+					0x3c, 0x2f, 0x61, 0x3e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x64, 0x69, 0x76, 0x3e, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x62, 0x6f, 0x64, 0x79, 0x3e, 0x0a,
+					0x3c, 0x2f, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "access.html.hbs",
+					size:    2396,
+					modTime: time.Unix(0, 1792421721495243799),
+					isDir:   false,
+				},
+			}, "/decide.html.hbs": File{
+				data: []byte{
+					0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x68, 0x74,
+					0x6d, 0x6c, 0x3e, 0x0a, 0x3c, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20,
+					0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x3d, 0x22, 0x75, 0x74, 0x66,
+					0x2d, 0x38, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2d,
+					0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x78, 0x2d, 0x75, 0x61, 0x2d,
+					0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x20,
+					0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x69, 0x65, 0x3d,
+					0x65, 0x64, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74,
+					0x70, 0x2d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x43, 0x6f, 0x6e,
+					0x74, 0x65, 0x6e, 0x74, 0x2d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+					0x79, 0x2d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x20, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x6e, 0x6f, 0x6e, 0x65,
+					0x27, 0x3b, 0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2d, 0x73, 0x72,
+					0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x20, 0x3b, 0x20, 0x63,
+					0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27,
+					0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x69, 0x6d, 0x67, 0x2d, 0x73,
+					0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x73,
+					0x74, 0x79, 0x6c, 0x65, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65,
+					0x6c, 0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+					0x66, 0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+					0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x20, 0x3b, 0x20, 0x66,
+					0x6f, 0x6e, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c,
+					0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66,
+					0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+					0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x4f, 0x41,
+					0x75, 0x74, 0x68, 0x20, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x3c,
+					0x2f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65,
+					0x6c, 0x3d, 0x22, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x20, 0x68, 0x72, 0x65,
+					0x66, 0x3d, 0x22, 0x2f, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x2e,
+					0x69, 0x63, 0x6f, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65, 0x6c, 0x3d,
+					0x22, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22,
+					0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x74, 0x65, 0x78, 0x74, 0x2f,
+					0x63, 0x73, 0x73, 0x22, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x68,
+					0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66, 0x6f, 0x6e, 0x74, 0x73,
+					0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e,
+					0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x73, 0x73, 0x3f, 0x66, 0x61, 0x6d, 0x69,
+					0x6c, 0x79, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2b, 0x53, 0x61,
+					0x6e, 0x73, 0x2b, 0x50, 0x72, 0x6f, 0x3a, 0x33, 0x30, 0x30, 0x2c, 0x34,
+					0x30, 0x30, 0x2c, 0x36, 0x30, 0x30, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x62, 0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x64, 0x69, 0x76, 0x20, 0x63, 0x6c, 0x61,
+					0x73, 0x73, 0x3d, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x61, 0x6c, 0x72,
+					0x65, 0x61, 0x64, 0x79, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+					0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x68, 0x31,
+					0x20, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x3d, 0x22, 0x74, 0x69, 0x74, 0x6c,
+					0x65, 0x22, 0x3e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x61,
+					0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x64, 0x65, 0x63, 0x69, 0x64,
+					0x65, 0x64, 0x3c, 0x2f, 0x68, 0x31, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x3c, 0x70, 0x3e, 0x7b, 0x7b, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+					0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x7c, 0x20, 0x65, 0x73, 0x63,
+					0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x27, 0x73, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x7b, 0x7b, 0x20,
+					0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x20, 0x7c,
+					0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20, 0x68,
+					0x61, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x62,
+					0x65, 0x65, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+					0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+					0x2e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6c,
+					0x73, 0x69, 0x66, 0x20, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x20,
+					0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x68, 0x31, 0x20,
+					0x63, 0x6c, 0x61, 0x73, 0x73, 0x3d, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65,
+					0x22, 0x3e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7b, 0x25,
+					0x20, 0x69, 0x66, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3d,
+					0x3d, 0x20, 0x22, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x20,
+					0x25, 0x7d, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x7b, 0x25,
+					0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x25, 0x7d, 0x72, 0x65, 0x6a, 0x65,
+					0x63, 0x74, 0x65, 0x64, 0x7b, 0x25, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66,
+					0x20, 0x25, 0x7d, 0x3c, 0x2f, 0x68, 0x31, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x70, 0x3e, 0x59, 0x6f, 0x75, 0x72, 0x20, 0x64, 0x65, 0x63,
+					0x69, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x6e, 0x20, 0x7b, 0x7b, 0x20,
+					0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+					0x20, 0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d,
+					0x27, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66,
+					0x6f, 0x72, 0x20, 0x7b, 0x7b, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+					0x2e, 0x61, 0x70, 0x70, 0x20, 0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70,
+					0x65, 0x20, 0x7d, 0x7d, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65,
+					0x6e, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x2e, 0x3c,
+					0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65,
+					0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x68, 0x31,
+					0x20, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x3d, 0x22, 0x74, 0x69, 0x74, 0x6c,
+					0x65, 0x22, 0x3e, 0x7b, 0x25, 0x20, 0x69, 0x66, 0x20, 0x61, 0x63, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x61, 0x70, 0x70, 0x72,
+					0x6f, 0x76, 0x65, 0x22, 0x20, 0x25, 0x7d, 0x41, 0x70, 0x70, 0x72, 0x6f,
+					0x76, 0x65, 0x7b, 0x25, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x25, 0x7d,
+					0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x7b, 0x25, 0x20, 0x65, 0x6e, 0x64,
+					0x69, 0x66, 0x20, 0x25, 0x7d, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3c, 0x2f, 0x68, 0x31,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x7b, 0x7b, 0x20,
+					0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+					0x20, 0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x61,
+					0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x7b, 0x7b, 0x20,
+					0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x20, 0x7c,
+					0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x2e, 0x3c,
+					0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20,
+					0x69, 0x66, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x6e, 0x65,
+					0x65, 0x64, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x3e, 0x20, 0x30, 0x20,
+					0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x3c, 0x70, 0x3e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f,
+					0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+					0x69, 0x6e, 0x67, 0x3a, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25,
+					0x2d, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x69,
+					0x6e, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x6e, 0x65, 0x65,
+					0x64, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c,
+					0x69, 0x3e, 0x7b, 0x7b, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x7c, 0x20,
+					0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x3c, 0x2f, 0x6c,
+					0x69, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x66, 0x6f,
+					0x72, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x2f, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x69, 0x66,
+					0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x72, 0x65, 0x61, 0x73,
+					0x6f, 0x6e, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x2d, 0x25, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e,
+					0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x7b, 0x7b, 0x20, 0x61,
+					0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+					0x20, 0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d,
+					0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d,
+					0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x6d, 0x65, 0x74,
+					0x68, 0x6f, 0x64, 0x3d, 0x22, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x20, 0x61,
+					0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3d, 0x22, 0x2f, 0x61, 0x63, 0x63, 0x65,
+					0x73, 0x73, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x22, 0x3e, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x69, 0x6e, 0x70,
+					0x75, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x68, 0x69, 0x64,
+					0x64, 0x65, 0x6e, 0x22, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x22, 0x61,
+					0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+					0x3d, 0x22, 0x7b, 0x7b, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x7d, 0x7d, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65,
+					0x3d, 0x22, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x20, 0x6e, 0x61,
+					0x6d, 0x65, 0x3d, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3d, 0x22, 0x7b, 0x7b, 0x20, 0x70,
+					0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x69, 0x6e, 0x70,
+					0x75, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x68, 0x69, 0x64,
+					0x64, 0x65, 0x6e, 0x22, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x22, 0x73,
+					0x69, 0x67, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3d, 0x22, 0x7b,
+					0x7b, 0x20, 0x73, 0x69, 0x67, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x69, 0x6e, 0x70, 0x75,
+					0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x68, 0x69, 0x64, 0x64,
+					0x65, 0x6e, 0x22, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x22, 0x63, 0x73,
+					0x72, 0x66, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3d, 0x22, 0x7b,
+					0x7b, 0x20, 0x63, 0x73, 0x72, 0x66, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c,
+					0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3d,
+					0x22, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x22, 0x3e, 0x7b, 0x25, 0x20,
+					0x69, 0x66, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x3d,
+					0x20, 0x22, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x20, 0x25,
+					0x7d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x7b, 0x25, 0x20, 0x65,
+					0x6c, 0x73, 0x65, 0x20, 0x25, 0x7d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+					0x7b, 0x25, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x25, 0x7d, 0x3c,
+					0x2f, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x3e, 0x3c, 0x2f, 0x70, 0x3e,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x3e,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d,
+					0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x61, 0x20, 0x68, 0x72, 0x65,
+					0x66, 0x3d, 0x22, 0x2f, 0x22, 0x3e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x6d, 0x65,
+					0x20, 0x70, 0x61, 0x67, 0x65, 0x3c, 0x2f, 0x61, 0x3e, 0x3c, 0x2f, 0x70,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f,
+					0x64, 0x69, 0x76, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x62,
+					0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x3c, 0x2f, 0x68, 0x74, 0x6d, 0x6c, 0x3e,
+					0x0a,
+				},
+				fi: FileInfo{
+					name:    "decide.html.hbs",
+					size:    2473,
+					modTime: time.Unix(0, 1792421721494906542),
+					isDir:   false,
+				},
+			}, "/denied.html.hbs": File{
+				data: []byte{
+					0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x68, 0x74,
+					0x6d, 0x6c, 0x3e, 0x0a, 0x3c, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20,
+					0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x3d, 0x22, 0x75, 0x74, 0x66,
+					0x2d, 0x38, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2d,
+					0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x78, 0x2d, 0x75, 0x61, 0x2d,
+					0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x20,
+					0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x69, 0x65, 0x3d,
+					0x65, 0x64, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74,
+					0x70, 0x2d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x43, 0x6f, 0x6e,
+					0x74, 0x65, 0x6e, 0x74, 0x2d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+					0x79, 0x2d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x20, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x6e, 0x6f, 0x6e, 0x65,
+					0x27, 0x3b, 0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2d, 0x73, 0x72,
+					0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x20, 0x3b, 0x20, 0x63,
+					0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27,
+					0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x69, 0x6d, 0x67, 0x2d, 0x73,
+					0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x73,
+					0x74, 0x79, 0x6c, 0x65, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65,
+					0x6c, 0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+					0x66, 0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+					0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x20, 0x3b, 0x20, 0x66,
+					0x6f, 0x6e, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c,
+					0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66,
+					0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+					0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x4f, 0x41,
+					0x75, 0x74, 0x68, 0x20, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x3c,
+					0x2f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65,
+					0x6c, 0x3d, 0x22, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x20, 0x68, 0x72, 0x65,
+					0x66, 0x3d, 0x22, 0x2f, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x2e,
+					0x69, 0x63, 0x6f, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65, 0x6c, 0x3d,
+					0x22, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22,
+					0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x74, 0x65, 0x78, 0x74, 0x2f,
+					0x63, 0x73, 0x73, 0x22, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x68,
+					0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66, 0x6f, 0x6e, 0x74, 0x73,
+					0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e,
+					0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x73, 0x73, 0x3f, 0x66, 0x61, 0x6d, 0x69,
+					0x6c, 0x79, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2b, 0x53, 0x61,
+					0x6e, 0x73, 0x2b, 0x50, 0x72, 0x6f, 0x3a, 0x33, 0x30, 0x30, 0x2c, 0x34,
+					0x30, 0x30, 0x2c, 0x36, 0x30, 0x30, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x62, 0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x64, 0x69, 0x76, 0x20, 0x63, 0x6c, 0x61,
+					0x73, 0x73, 0x3d, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x68, 0x31, 0x20, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x3d,
+					0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x3e, 0x4e, 0x6f, 0x74, 0x20,
+					0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x3c, 0x2f,
+					0x68, 0x31, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x59, 0x6f, 0x75, 0x20, 0x73,
+					0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x20,
+					0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x20, 0x61, 0x73, 0x20, 0x7b, 0x7b,
+					0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x62,
+					0x75, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x63, 0x63, 0x6f,
+					0x75, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x61, 0x6c,
+					0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x67,
+					0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x72, 0x65, 0x2e, 0x3c, 0x2f, 0x70,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x70, 0x3e, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x61, 0x73,
+					0x6f, 0x6e, 0x20, 0x7d, 0x7d, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25,
+					0x2d, 0x20, 0x69, 0x66, 0x20, 0x68, 0x65, 0x6c, 0x70, 0x5f, 0x75, 0x72,
+					0x6c, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x2d, 0x25, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x61, 0x20, 0x68, 0x72,
+					0x65, 0x66, 0x3d, 0x22, 0x7b, 0x7b, 0x20, 0x68, 0x65, 0x6c, 0x70, 0x5f,
+					0x75, 0x72, 0x6c, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x7b, 0x7b, 0x20, 0x68,
+					0x65, 0x6c, 0x70, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x20, 0x7d, 0x7d, 0x3c,
+					0x2f, 0x61, 0x3e, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x3c, 0x61,
+					0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x2f, 0x61, 0x75, 0x74, 0x68,
+					0x22, 0x3e, 0x74, 0x72, 0x79, 0x20, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+					0x67, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x3c, 0x2f,
+					0x61, 0x3e, 0x2e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20,
+					0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25,
+					0x2d, 0x20, 0x69, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+					0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x20, 0x2d, 0x25, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x49, 0x66, 0x20, 0x79, 0x6f,
+					0x75, 0x20, 0x74, 0x68, 0x69, 0x6e, 0x6b, 0x20, 0x74, 0x68, 0x69, 0x73,
+					0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b,
+					0x65, 0x2c, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x20, 0x7b,
+					0x7b, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
+					0x74, 0x61, 0x63, 0x74, 0x20, 0x7d, 0x7d, 0x2e, 0x3c, 0x2f, 0x70, 0x3e,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d,
+					0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x61, 0x20, 0x68, 0x72, 0x65,
+					0x66, 0x3d, 0x22, 0x2f, 0x22, 0x3e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x6d, 0x65,
+					0x20, 0x70, 0x61, 0x67, 0x65, 0x3c, 0x2f, 0x61, 0x3e, 0x3c, 0x2f, 0x70,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f,
+					0x64, 0x69, 0x76, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x62,
+					0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x3c, 0x2f, 0x68, 0x74, 0x6d, 0x6c, 0x3e,
+					0x0a,
+				},
+				fi: FileInfo{
+					name:    "denied.html.hbs",
+					size:    1225,
+					modTime: time.Unix(0, 1792419268388779013),
+					isDir:   false,
+				},
+			}, "/favicon.ico": File{
 				data: []byte{
 					0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x10, 0x10, 0x00, 0x00, 0x01, 0x00,
 					0x20, 0x00, 0x68, 0x04, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00, 0x20, 0x20,
@@ -675,94 +1212,244 @@ func init() {
 					0x20, 0x20, 0x3c, 0x70, 0x3e, 0x59, 0x6f, 0x75, 0x27, 0x72, 0x65, 0x20,
 					0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x6c, 0x6f, 0x67, 0x67,
 					0x65, 0x64, 0x20, 0x69, 0x6e, 0x2c, 0x20, 0x7b, 0x7b, 0x20, 0x73, 0x65,
+					0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7c,
+					0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x20, 0x73, 0x65,
 					0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x20,
-					0x7d, 0x7d, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
-					0x25, 0x2d, 0x20, 0x69, 0x66, 0x20, 0x6f, 0x72, 0x67, 0x73, 0x2e, 0x73,
-					0x69, 0x7a, 0x65, 0x20, 0x3e, 0x20, 0x30, 0x20, 0x2d, 0x25, 0x7d, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x59,
-					0x6f, 0x75, 0x72, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
-					0x69, 0x70, 0x73, 0x3a, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x3c,
+					0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20,
+					0x69, 0x66, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x45,
+					0x6d, 0x61, 0x69, 0x6c, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x2d,
+					0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c,
+					0x70, 0x3e, 0x59, 0x6f, 0x75, 0x72, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66,
+					0x69, 0x65, 0x64, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x3a, 0x20, 0x7b,
+					0x7b, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
+					0x61, 0x69, 0x6c, 0x20, 0x7c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65,
+					0x20, 0x7d, 0x7d, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20,
+					0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x69, 0x66,
+					0x20, 0x6f, 0x72, 0x67, 0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x3e,
+					0x20, 0x30, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25,
-					0x2d, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6f, 0x72, 0x67, 0x20, 0x69, 0x6e,
-					0x20, 0x6f, 0x72, 0x67, 0x73, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x59, 0x6f, 0x75, 0x72, 0x20, 0x6d,
+					0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x3a, 0x3c,
+					0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x3c, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x3c, 0x6c, 0x69, 0x3e, 0x7b, 0x7b, 0x20, 0x6f, 0x72, 0x67,
-					0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x66, 0x6f, 0x72,
+					0x20, 0x6f, 0x72, 0x67, 0x20, 0x69, 0x6e, 0x20, 0x6f, 0x72, 0x67, 0x73,
+					0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d,
+					0x20, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x20, 0x6d, 0x65, 0x6d, 0x62,
+					0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x3d, 0x20, 0x73, 0x65, 0x73,
+					0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x67, 0x73, 0x5b, 0x6f, 0x72,
+					0x67, 0x5d, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x3c, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c,
+					0x6c, 0x69, 0x3e, 0x7b, 0x7b, 0x20, 0x6f, 0x72, 0x67, 0x20, 0x7d, 0x7d,
+					0x7b, 0x25, 0x20, 0x69, 0x66, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+					0x73, 0x68, 0x69, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x20, 0x21, 0x3d,
+					0x20, 0x22, 0x22, 0x20, 0x25, 0x7d, 0x20, 0x28, 0x7b, 0x7b, 0x20, 0x6d,
+					0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x52, 0x6f,
+					0x6c, 0x65, 0x20, 0x7d, 0x7d, 0x29, 0x7b, 0x25, 0x20, 0x65, 0x6e, 0x64,
+					0x69, 0x66, 0x20, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x61, 0x73, 0x73,
-					0x69, 0x67, 0x6e, 0x20, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x3d, 0x20,
-					0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
-					0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x5b, 0x6f, 0x72, 0x67, 0x5d,
-					0x20, 0x7c, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x20, 0x2d, 0x25, 0x7d, 0x0a,
+					0x20, 0x20, 0x3c, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x61,
+					0x73, 0x73, 0x69, 0x67, 0x6e, 0x20, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x20,
+					0x3d, 0x20, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x5b,
+					0x6f, 0x72, 0x67, 0x5d, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x7b, 0x25, 0x2d, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x65, 0x61, 0x6d,
-					0x20, 0x69, 0x6e, 0x20, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x2d, 0x25,
-					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d,
+					0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x65, 0x61, 0x6d, 0x20, 0x69, 0x6e,
+					0x20, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69, 0x3e, 0x7b, 0x7b,
-					0x20, 0x74, 0x65, 0x61, 0x6d, 0x20, 0x7d, 0x7d, 0x3c, 0x2f, 0x6c, 0x69,
-					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x66, 0x6f, 0x72,
+					0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x61, 0x73, 0x73, 0x69, 0x67,
+					0x6e, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x3d, 0x20, 0x6d, 0x65, 0x6d,
+					0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+					0x73, 0x5b, 0x74, 0x65, 0x61, 0x6d, 0x5d, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
 					0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x3c, 0x2f, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c,
-					0x2f, 0x6c, 0x69, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69,
+					0x3e, 0x7b, 0x7b, 0x20, 0x74, 0x65, 0x61, 0x6d, 0x20, 0x7d, 0x7d, 0x7b,
+					0x25, 0x20, 0x69, 0x66, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x21, 0x3d,
+					0x20, 0x22, 0x22, 0x20, 0x25, 0x7d, 0x20, 0x28, 0x7b, 0x7b, 0x20, 0x72,
+					0x6f, 0x6c, 0x65, 0x20, 0x7d, 0x7d, 0x29, 0x7b, 0x25, 0x20, 0x65, 0x6e,
+					0x64, 0x69, 0x66, 0x20, 0x25, 0x7d, 0x3c, 0x2f, 0x6c, 0x69, 0x3e, 0x0a,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64,
-					0x66, 0x6f, 0x72, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x2d,
+					0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x66, 0x6f, 0x72, 0x20, 0x2d,
 					0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c,
-					0x70, 0x3e, 0x59, 0x6f, 0x75, 0x20, 0x64, 0x6f, 0x6e, 0x27, 0x74, 0x20,
-					0x73, 0x65, 0x65, 0x6d, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65,
-					0x20, 0x61, 0x6e, 0x79, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-					0x68, 0x69, 0x70, 0x73, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x2f, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d,
-					0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x61,
-					0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x2f, 0x6c, 0x6f, 0x67, 0x6f,
-					0x75, 0x74, 0x22, 0x3e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x20, 0x68, 0x65,
-					0x72, 0x65, 0x3c, 0x2f, 0x61, 0x3e, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f,
-					0x67, 0x20, 0x6f, 0x75, 0x74, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25,
-					0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x6c,
+					0x69, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x66, 0x6f,
+					0x72, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x2f, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x3c, 0x61, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22,
-					0x2f, 0x61, 0x75, 0x74, 0x68, 0x22, 0x3e, 0x43, 0x6c, 0x69, 0x63, 0x6b,
-					0x20, 0x68, 0x65, 0x72, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x67,
-					0x20, 0x69, 0x6e, 0x3c, 0x2f, 0x61, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x2d, 0x25, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e,
+					0x59, 0x6f, 0x75, 0x20, 0x64, 0x6f, 0x6e, 0x27, 0x74, 0x20, 0x73, 0x65,
+					0x65, 0x6d, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x61,
+					0x6e, 0x79, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+					0x70, 0x73, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x3c, 0x61, 0x20, 0x68,
+					0x72, 0x65, 0x66, 0x3d, 0x22, 0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+					0x22, 0x3e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x20, 0x68, 0x65, 0x72, 0x65,
+					0x3c, 0x2f, 0x61, 0x3e, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x67, 0x20,
+					0x6f, 0x75, 0x74, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20,
-					0x65, 0x6e, 0x64, 0x69, 0x66, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x64, 0x69, 0x76, 0x3e,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x62, 0x6f, 0x64, 0x79, 0x3e,
-					0x0a, 0x3c, 0x2f, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a,
+					0x65, 0x6c, 0x73, 0x65, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x61, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x2f, 0x61,
+					0x75, 0x74, 0x68, 0x22, 0x3e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x20, 0x68,
+					0x65, 0x72, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x67, 0x20, 0x69,
+					0x6e, 0x3c, 0x2f, 0x61, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x65, 0x6e,
+					0x64, 0x69, 0x66, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x64, 0x69, 0x76, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x2f, 0x62, 0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x3c,
+					0x2f, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "index.html.hbs",
-					size:    1749,
-					modTime: time.Unix(0, 1646880467712416082),
+					size:    2191,
+					modTime: time.Unix(0, 1792421540929546580),
+					isDir:   false,
+				},
+			}, "/sso.html.hbs": File{
+				data: []byte{
+					0x3c, 0x21, 0x44, 0x4f, 0x43, 0x54, 0x59, 0x50, 0x45, 0x20, 0x68, 0x74,
+					0x6d, 0x6c, 0x3e, 0x0a, 0x3c, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20,
+					0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x3d, 0x22, 0x75, 0x74, 0x66,
+					0x2d, 0x38, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2d,
+					0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x78, 0x2d, 0x75, 0x61, 0x2d,
+					0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x20,
+					0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x69, 0x65, 0x3d,
+					0x65, 0x64, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x6d, 0x65, 0x74, 0x61, 0x20, 0x68, 0x74, 0x74,
+					0x70, 0x2d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x3d, 0x22, 0x43, 0x6f, 0x6e,
+					0x74, 0x65, 0x6e, 0x74, 0x2d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+					0x79, 0x2d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x20, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3d, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x6e, 0x6f, 0x6e, 0x65,
+					0x27, 0x3b, 0x20, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2d, 0x73, 0x72,
+					0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x20, 0x3b, 0x20, 0x63,
+					0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27,
+					0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x69, 0x6d, 0x67, 0x2d, 0x73,
+					0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x66, 0x27, 0x3b, 0x20, 0x73,
+					0x74, 0x79, 0x6c, 0x65, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65,
+					0x6c, 0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+					0x66, 0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+					0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x20, 0x3b, 0x20, 0x66,
+					0x6f, 0x6e, 0x74, 0x2d, 0x73, 0x72, 0x63, 0x20, 0x27, 0x73, 0x65, 0x6c,
+					0x66, 0x27, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66,
+					0x6f, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+					0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x4f, 0x41,
+					0x75, 0x74, 0x68, 0x20, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x3c,
+					0x2f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65,
+					0x6c, 0x3d, 0x22, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x20, 0x68, 0x72, 0x65,
+					0x66, 0x3d, 0x22, 0x2f, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x2e,
+					0x69, 0x63, 0x6f, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x72, 0x65, 0x6c, 0x3d,
+					0x22, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22,
+					0x20, 0x74, 0x79, 0x70, 0x65, 0x3d, 0x22, 0x74, 0x65, 0x78, 0x74, 0x2f,
+					0x63, 0x73, 0x73, 0x22, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x68,
+					0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x66, 0x6f, 0x6e, 0x74, 0x73,
+					0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e,
+					0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x73, 0x73, 0x3f, 0x66, 0x61, 0x6d, 0x69,
+					0x6c, 0x79, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2b, 0x53, 0x61,
+					0x6e, 0x73, 0x2b, 0x50, 0x72, 0x6f, 0x3a, 0x33, 0x30, 0x30, 0x2c, 0x34,
+					0x30, 0x30, 0x2c, 0x36, 0x30, 0x30, 0x22, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x3e, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x62, 0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x64, 0x69, 0x76, 0x20, 0x63, 0x6c, 0x61,
+					0x73, 0x73, 0x3d, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+					0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x68, 0x31, 0x20, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x3d,
+					0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x3e, 0x53, 0x69, 0x6e, 0x67,
+					0x6c, 0x65, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x2d, 0x6f, 0x6e, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x3c, 0x2f, 0x68, 0x31, 0x3e,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3c, 0x70, 0x3e, 0x59, 0x6f, 0x75, 0x20, 0x73, 0x69, 0x67, 0x6e,
+					0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x47, 0x69, 0x74,
+					0x48, 0x75, 0x62, 0x20, 0x61, 0x73, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f,
+					0x67, 0x69, 0x6e, 0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x62, 0x75, 0x74, 0x20,
+					0x74, 0x68, 0x65, 0x73, 0x65, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+					0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x69, 0x72, 0x65, 0x20, 0x53, 0x41, 0x4d, 0x4c, 0x20, 0x73, 0x69, 0x6e,
+					0x67, 0x6c, 0x65, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x2d, 0x6f, 0x6e, 0x20,
+					0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x79, 0x6f, 0x75, 0x72, 0x20,
+					0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x20,
+					0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x3a,
+					0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x25, 0x2d, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6c, 0x69,
+					0x6e, 0x6b, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x73, 0x6f, 0x5f, 0x6c, 0x69,
+					0x6e, 0x6b, 0x73, 0x20, 0x2d, 0x25, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x6c, 0x69, 0x3e, 0x3c, 0x61, 0x20, 0x68,
+					0x72, 0x65, 0x66, 0x3d, 0x22, 0x7b, 0x7b, 0x20, 0x6c, 0x69, 0x6e, 0x6b,
+					0x2e, 0x75, 0x72, 0x6c, 0x20, 0x7d, 0x7d, 0x22, 0x3e, 0x41, 0x75, 0x74,
+					0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x69,
+					0x6e, 0x6b, 0x2e, 0x6f, 0x72, 0x67, 0x20, 0x7d, 0x7d, 0x3c, 0x2f, 0x61,
+					0x3e, 0x3c, 0x2f, 0x6c, 0x69, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x25, 0x2d, 0x20, 0x65, 0x6e, 0x64, 0x66, 0x6f, 0x72, 0x20, 0x2d, 0x25,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x3c, 0x2f, 0x75, 0x6c, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x4f,
+					0x6e, 0x63, 0x65, 0x20, 0x79, 0x6f, 0x75, 0x27, 0x76, 0x65, 0x20, 0x73,
+					0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x20,
+					0x65, 0x61, 0x63, 0x68, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+					0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x3c, 0x61, 0x20, 0x68, 0x72,
+					0x65, 0x66, 0x3d, 0x22, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x22, 0x3e, 0x74,
+					0x72, 0x79, 0x20, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x69,
+					0x6e, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x3c, 0x2f, 0x61, 0x3e, 0x2e,
+					0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x3c, 0x70, 0x3e, 0x4f, 0x72, 0x20, 0x3c,
+					0x61, 0x20, 0x68, 0x72, 0x65, 0x66, 0x3d, 0x22, 0x2f, 0x61, 0x75, 0x74,
+					0x68, 0x3f, 0x73, 0x73, 0x6f, 0x3d, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x3e,
+					0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x20, 0x77, 0x69, 0x74,
+					0x68, 0x6f, 0x75, 0x74, 0x20, 0x74, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x6d,
+					0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x3c, 0x2f,
+					0x61, 0x3e, 0x2e, 0x3c, 0x2f, 0x70, 0x3e, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x3c, 0x2f, 0x64, 0x69, 0x76, 0x3e, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x3c, 0x2f, 0x62, 0x6f, 0x64, 0x79, 0x3e, 0x0a, 0x3c,
+					0x2f, 0x68, 0x74, 0x6d, 0x6c, 0x3e, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "sso.html.hbs",
+					size:    1243,
+					modTime: time.Unix(0, 1792419335123715012),
 					isDir:   false,
 				},
 			},
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/sync/errgroup"
+)
+
+const teamCacheTTL = 10 * time.Minute
+
+type teamCacheEntry struct {
+	team    *github.Team
+	fetched time.Time
+}
+
+var (
+	teamCache      = map[int64]teamCacheEntry{}
+	teamCacheMutex sync.RWMutex
+)
+
+func cachedTeam(id int64) (*github.Team, bool) {
+	teamCacheMutex.RLock()
+	defer teamCacheMutex.RUnlock()
+	entry, ok := teamCache[id]
+	if !ok || time.Since(entry.fetched) > teamCacheTTL {
+		return nil, false
+	}
+	return entry.team, true
+}
+
+func getTeam(ctx context.Context, client *github.Client, id int64) (*github.Team, error) {
+	if team, ok := cachedTeam(id); ok {
+		return team, nil
+	}
+
+	callCtx, done := startCall(ctx, "github.teams.get")
+	team, resp, err := client.Teams.GetTeam(callCtx, id)
+	done(err)
+	if isSSOError(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	recordRate(resp)
+
+	teamCacheMutex.Lock()
+	teamCache[id] = teamCacheEntry{team: team, fetched: time.Now()}
+	teamCacheMutex.Unlock()
+	return team, nil
+}
+
+// resolveParents walks up the team hierarchy from the user's direct teams,
+// returning the ancestor teams they inherit membership of
+func resolveParents(ctx context.Context, client *github.Client, direct []*github.Team) ([]*github.Team, error) {
+	seen := map[int64]bool{}
+	for _, t := range direct {
+		seen[t.GetID()] = true
+	}
+
+	var inherited []*github.Team
+	frontier := direct
+	for len(frontier) > 0 {
+		var pending []int64
+		for _, t := range frontier {
+			parent := t.GetParent()
+			if parent == nil || seen[parent.GetID()] {
+				continue
+			}
+			seen[parent.GetID()] = true
+			pending = append(pending, parent.GetID())
+		}
+
+		parents := make([]*github.Team, len(pending))
+		g, gctx := errgroup.WithContext(ctx)
+		for idx, id := range pending {
+			idx, id := idx, id
+			g.Go(func() error {
+				team, err := getTeam(gctx, client, id)
+				parents[idx] = team
+				return err
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+
+		frontier = nil
+		for _, team := range parents {
+			if team != nil {
+				frontier = append(frontier, team)
+			}
+		}
+		inherited = append(inherited, frontier...)
+	}
+	return inherited, nil
+}
+
+// fetchTeamRoles looks up the user's role in each team, omitting teams they
+// aren't an active member of
+func fetchTeamRoles(ctx context.Context, client *github.Client, login string, teams []*github.Team) (map[int64]string, error) {
+	roles := make([]*string, len(teams))
+	g, ctx := errgroup.WithContext(ctx)
+	for idx, t := range teams {
+		idx, t := idx, t
+		g.Go(func() error {
+			callCtx, done := startCall(ctx, "github.teams.membership")
+			membership, resp, err := client.Teams.GetTeamMembership(callCtx, t.GetID(), login)
+			done(err)
+			if isSSOError(err) || isNotFound(err) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			recordRate(resp)
+			if membership.GetState() == "active" {
+				roles[idx] = membership.Role
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]string, len(teams))
+	for idx, t := range teams {
+		if roles[idx] != nil {
+			result[t.GetID()] = *roles[idx]
+		}
+	}
+	return result, nil
+}
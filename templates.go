@@ -12,6 +12,10 @@ var (
 	engine        *liquid.Engine
 	templateNames = []string{
 		"/index.html",
+		"/denied.html",
+		"/sso.html",
+		"/access.html",
+		"/decide.html",
 	}
 	templates = map[string]*liquid.Template{}
 )
@@ -49,30 +53,39 @@ func init() {
 	}
 }
 
-func newTemplateContext(req events.Request) (map[string]interface{}, error) {
+func newTemplateContext(req events.Request, vars map[string]interface{}) (map[string]interface{}, error) {
 	session, err := sm.Read(req)
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
-	orgs := make([]string, len(session.Memberships))
-	idx := 0
-	for org := range session.Memberships {
-		orgs[idx] = org
-		idx++
+	orgs := make([]string, 0, len(session.Orgs))
+	orgTeams := map[string][]string{}
+	for org, membership := range session.Orgs {
+		orgs = append(orgs, org)
+		teams := make([]string, 0, len(membership.Teams))
+		for team := range membership.Teams {
+			teams = append(teams, team)
+		}
+		sort.Strings(teams)
+		orgTeams[org] = teams
 	}
 	sort.Strings(orgs)
 
 	tc := map[string]interface{}{
-		"request": req,
-		"config":  config.TemplateData,
-		"session": session,
-		"orgs":    orgs,
+		"request":   req,
+		"config":    config.TemplateData,
+		"session":   session,
+		"orgs":      orgs,
+		"org_teams": orgTeams,
+	}
+	for k, v := range vars {
+		tc[k] = v
 	}
 	return tc, nil
 }
 
-func execTemplate(name string, req events.Request) (string, error) {
-	ctx, err := newTemplateContext(req)
+func execTemplate(name string, req events.Request, vars map[string]interface{}) (string, error) {
+	ctx, err := newTemplateContext(req, vars)
 	if err != nil {
 		return "", err
 	}
@@ -85,3 +98,17 @@ func execTemplate(name string, req events.Request) (string, error) {
 	page, err := tpl.RenderString(ctx)
 	return page, err
 }
+
+func renderPage(req events.Request, name string, code int, vars map[string]interface{}) (events.Response, error) {
+	page, err := execTemplate(name, req, vars)
+	if err != nil {
+		return fail(req, fmt.Sprintf("failed to exec template: %s", err))
+	}
+	return events.Response{
+		StatusCode: code,
+		Body:       page,
+		Headers: map[string]string{
+			"Content-Type": "text/html; charset=utf-8",
+		},
+	}, nil
+}
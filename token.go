@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/akerl/github-auth-lambda/session"
+	"golang.org/x/oauth2"
+)
+
+// storeTokens reports whether the user's token should be kept in the session,
+// which is needed to revalidate memberships later
+func storeTokens() bool {
+	return config.GitHubApp || config.Revalidate > 0
+}
+
+// storedToken holds the user's GitHub token so that memberships can be
+// revalidated. Every app using SessionCheck can decrypt the session cookie, so
+// it is sealed with a ServerKey-derived key before being stored there.
+type storedToken struct {
+	AccessToken   string    `json:"access_token"`
+	RefreshToken  string    `json:"refresh_token"`
+	Expiry        time.Time `json:"expiry"`
+	RefreshExpiry time.Time `json:"refresh_expiry"`
+}
+
+func sealToken(token *oauth2.Token) (string, error) {
+	t := storedToken{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		Expiry:       token.Expiry,
+	}
+	// GitHub App refresh tokens expire too, after refresh_token_expires_in seconds
+	if expiresIn, ok := token.Extra("refresh_token_expires_in").(float64); ok && expiresIn > 0 {
+		t.RefreshExpiry = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal("token", data)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+func openToken(sealed string) (*storedToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+	data, err = unseal("token", data)
+	if err != nil {
+		return nil, err
+	}
+	var t storedToken
+	err = json.Unmarshal(data, &t)
+	return &t, err
+}
+
+func oauthToken(token *storedToken) *oauth2.Token {
+	return &oauth2.Token{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		Expiry:       token.Expiry,
+		TokenType:    "bearer",
+	}
+}
+
+func revalidationDue(sess session.Session) bool {
+	if config.Revalidate <= 0 {
+		return false
+	}
+	validated := time.Unix(sess.ValidatedAt, 0)
+	return time.Since(validated) > time.Duration(config.Revalidate)*time.Second
+}
+
+// refreshToken returns a usable token from the session, refreshing it if it
+// has expired
+func refreshToken(ctx context.Context, sess session.Session) (*oauth2.Token, error) {
+	if sess.SealedToken == "" {
+		return nil, fmt.Errorf("no token stored in session")
+	}
+	stored, err := openToken(sess.SealedToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stored token: %s", err)
+	}
+	if !stored.RefreshExpiry.IsZero() && time.Now().After(stored.RefreshExpiry) {
+		return nil, fmt.Errorf("refresh token has expired")
+	}
+
+	callCtx, done := startCall(ctx, "oauth.refresh")
+	token, err := oauthCfg.TokenSource(callCtx, oauthToken(stored)).Token()
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid() {
+		return nil, fmt.Errorf("refreshed token is invalid")
+	}
+	return token, nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/akerl/github-auth-lambda"
+	// traceFlushTimeout bounds how long a response waits on the exporter
+	traceFlushTimeout = 2 * time.Second
+)
+
+var (
+	tracer         trace.Tracer = otel.Tracer(tracerName)
+	tracerProvider *sdktrace.TracerProvider
+)
+
+func newTraceExporter() (sdktrace.SpanExporter, error) {
+	switch config.TraceExporter {
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "otlp":
+		opts := []otlptracehttp.Option{}
+		if config.TraceEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(config.TraceEndpoint))
+		}
+		return otlptracehttp.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter: %s", config.TraceExporter)
+	}
+}
+
+func initTracing() error {
+	if config.TraceExporter == "" {
+		return nil
+	}
+
+	exporter, err := newTraceExporter()
+	if err != nil {
+		return err
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName("github-auth-lambda"),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	tracer = tracerProvider.Tracer(tracerName)
+	return nil
+}
+
+func flushTraces(ctx context.Context) {
+	if tracerProvider == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, traceFlushTimeout)
+	defer cancel()
+	err := tracerProvider.ForceFlush(ctx)
+	if err != nil {
+		requestLog.Error("failed to flush traces", "error", err)
+	}
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}